@@ -6,12 +6,14 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v3"
 
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 
+	"github.com/algorand/node-ui/config"
 	"github.com/algorand/node-ui/messages"
 	"github.com/algorand/node-ui/tui"
 	"github.com/algorand/node-ui/version"
@@ -26,12 +28,31 @@ func main() {
 
 // TODO "r" to set the refresh rate
 type arguments struct {
-	tuiPort          uint64
-	algodURL         string
-	algodToken       string
-	algodDataDir     string
-	addressWatchList []string
-	versionFlag      bool
+	tuiPort           uint64
+	algodURL          string
+	algodToken        string
+	algodAdminToken   string
+	algodDataDir      string
+	addressWatchList  []string
+	stallThreshold    time.Duration
+	catchupThreshold  time.Duration
+	behindThreshold   uint64
+	behindCritical    uint64
+	consensusFile     string
+	referenceURL      string
+	referenceToken    string
+	networkRound      uint64
+	configPath        string
+	addressBookPath   string
+	allowConfigWrite  bool
+	sshAuthorizedKeys string
+	sshBind           string
+	sshHostKey        string
+	sshMaxSessions    uint64
+	sshMaxUser        uint64
+	sshIdleTimeout    time.Duration
+	auditLogPath      string
+	versionFlag       bool
 }
 
 func run(args arguments) {
@@ -39,9 +60,33 @@ func run(args arguments) {
 		fmt.Println(version.LongVersion())
 		os.Exit(0)
 	}
-	request := getRequestorOrExit(args.algodDataDir, args.algodURL, args.algodToken)
-	addresses := getAddressesOrExit(args.addressWatchList)
-	tui.Start(args.tuiPort, request, addresses)
+	cfg := getConfigOrExit(args.configPath)
+	request := getRequestorOrExit(args.algodDataDir, args.algodURL, args.algodToken, args.algodAdminToken)
+	request.Reference = getReferenceOrExit(args.referenceURL, args.referenceToken)
+	book := getAddressBookOrExit(args.addressBookPath)
+	addresses := getAddressesOrExit(mergeWatchList(cfg.WatchList, args.addressWatchList), book)
+	consensus := getConsensusOrExit(args.consensusFile, request.DataDir())
+	audit := getAuditLogOrExit(args.auditLogPath)
+	tui.Start(args.tuiPort, request, addresses, tui.Options{
+		StallThreshold:          args.stallThreshold,
+		CatchupThreshold:        args.catchupThreshold,
+		BehindThreshold:         args.behindThreshold,
+		BehindCriticalThreshold: args.behindCritical,
+		Consensus:               consensus,
+		NetworkRound:            args.networkRound,
+		Config:                  cfg,
+		AddressBook:             book,
+		AllowConfigWrite:        args.allowConfigWrite,
+		SSHAuthorizedKeys:       args.sshAuthorizedKeys,
+		SSHBind:                 args.sshBind,
+		SSHHostKey:              args.sshHostKey,
+		Sessions: tui.SessionLimits{
+			MaxSessions:     int(args.sshMaxSessions),
+			MaxUserSessions: int(args.sshMaxUser),
+			IdleTimeout:     args.sshIdleTimeout,
+		},
+		Audit: audit,
+	})
 }
 
 func makeCommand() *cli.Command {
@@ -74,6 +119,13 @@ func makeCommand() *cli.Command {
 				Sources:     cli.EnvVars("ALGOD_TOKEN"),
 				Destination: &args.algodToken,
 			},
+			&cli.StringFlag{
+				Name:        "algod-admin-token",
+				Usage:       "Algod REST API admin token, read from the data directory if not provided.",
+				Value:       "",
+				Sources:     cli.EnvVars("ALGOD_ADMIN_TOKEN"),
+				Destination: &args.algodAdminToken,
+			},
 			&cli.StringFlag{
 				Name:        "algod-data-dir",
 				Aliases:     []string{"d"},
@@ -85,11 +137,138 @@ func makeCommand() *cli.Command {
 			&cli.StringSliceFlag{
 				Name:        "watch-list",
 				Aliases:     []string{"w"},
-				Usage:       "Account addresses to watch in the accounts tab, may provide more than once to watch multiple accounts. Use comma separated values if providing more than one account with an environment variable.",
+				Usage:       "Account addresses or address book labels to watch in the accounts tab, may provide more than once to watch multiple accounts. Use comma separated values if providing more than one account with an environment variable.",
 				Value:       nil,
 				Sources:     cli.EnvVars("WATCH_LIST"),
 				Destination: &args.addressWatchList,
 			},
+			&cli.DurationFlag{
+				Name:        "stall-threshold",
+				Usage:       "Flag the node as stalled when no new round is seen for this long, set to 0 to disable.",
+				Value:       20 * time.Second,
+				Sources:     cli.EnvVars("STALL_THRESHOLD"),
+				Destination: &args.stallThreshold,
+			},
+			&cli.DurationFlag{
+				Name:        "catchup-threshold",
+				Usage:       "Flag the node when it has been catching up for this long, set to 0 to disable.",
+				Value:       5 * time.Minute,
+				Sources:     cli.EnvVars("CATCHUP_THRESHOLD"),
+				Destination: &args.catchupThreshold,
+			},
+			&cli.Uint64Flag{
+				Name:        "behind-threshold",
+				Usage:       "Warn when the node is more than this many rounds behind the network round, set to 0 to disable.",
+				Value:       10,
+				Sources:     cli.EnvVars("BEHIND_THRESHOLD"),
+				Destination: &args.behindThreshold,
+			},
+			&cli.Uint64Flag{
+				Name:        "behind-critical-threshold",
+				Usage:       "Flag the node as critical when it is more than this many rounds behind the network round, set to 0 to disable.",
+				Value:       1000,
+				Sources:     cli.EnvVars("BEHIND_CRITICAL_THRESHOLD"),
+				Destination: &args.behindCritical,
+			},
+			&cli.StringFlag{
+				Name:        "consensus-file",
+				Usage:       "Path to a consensus.json file with consensus parameter overrides for private networks. Defaults to consensus.json in the data directory if it exists.",
+				Value:       "",
+				Sources:     cli.EnvVars("CONSENSUS_FILE"),
+				Destination: &args.consensusFile,
+			},
+			&cli.StringFlag{
+				Name:        "reference-url",
+				Usage:       "Algod URL of a synced reference node, used to estimate the time to sync when catching up.",
+				Value:       "",
+				Sources:     cli.EnvVars("REFERENCE_ALGOD_URL"),
+				Destination: &args.referenceURL,
+			},
+			&cli.StringFlag{
+				Name:        "reference-token",
+				Usage:       "Algod REST API token for the reference node.",
+				Value:       "",
+				Sources:     cli.EnvVars("REFERENCE_ALGOD_TOKEN"),
+				Destination: &args.referenceToken,
+			},
+			&cli.Uint64Flag{
+				Name:        "network-round",
+				Usage:       "Latest network round, used to estimate the time to sync when there is no reference node.",
+				Value:       0,
+				Sources:     cli.EnvVars("NETWORK_ROUND"),
+				Destination: &args.networkRound,
+			},
+			&cli.StringFlag{
+				Name:        "config",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the settings file with the watch list, refresh rates, default tab and theme. Defaults to ~/.config/nodeui/config.yaml.",
+				Value:       "",
+				Sources:     cli.EnvVars("NODEUI_CONFIG"),
+				Destination: &args.configPath,
+			},
+			&cli.StringFlag{
+				Name:        "address-book",
+				Usage:       "Path to a CSV (label,address) or JSON (label to address) file of account labels. Labels are shown wherever addresses are rendered.",
+				Value:       "",
+				Sources:     cli.EnvVars("ADDRESS_BOOK"),
+				Destination: &args.addressBookPath,
+			},
+			&cli.BoolFlag{
+				Name:        "allow-config-write",
+				Usage:       "Allow editing the node config.json from the configuration tab. The previous file is backed up before each change.",
+				Value:       false,
+				Sources:     cli.EnvVars("ALLOW_CONFIG_WRITE"),
+				Destination: &args.allowConfigWrite,
+			},
+			&cli.StringFlag{
+				Name:        "ssh-authorized-keys",
+				Usage:       "Path to an authorized_keys file, only these keys may connect to the SSH server. Add role=operator to a key to allow fast catchup, config edits and participation key changes, other keys are viewers.",
+				Value:       "",
+				Sources:     cli.EnvVars("SSH_AUTHORIZED_KEYS"),
+				Destination: &args.sshAuthorizedKeys,
+			},
+			&cli.StringFlag{
+				Name:        "ssh-bind",
+				Usage:       "Address the SSH server listens on.",
+				Value:       "0.0.0.0",
+				Sources:     cli.EnvVars("SSH_BIND"),
+				Destination: &args.sshBind,
+			},
+			&cli.StringFlag{
+				Name:        "ssh-host-key",
+				Usage:       "Path to the SSH host key, an ed25519 key is generated when it does not exist. Defaults to ~/.ssh/term_info_ed25519.",
+				Value:       "",
+				Sources:     cli.EnvVars("SSH_HOST_KEY"),
+				Destination: &args.sshHostKey,
+			},
+			&cli.Uint64Flag{
+				Name:        "ssh-max-sessions",
+				Usage:       "Maximum number of concurrent SSH sessions, set to 0 for no limit.",
+				Value:       0,
+				Sources:     cli.EnvVars("SSH_MAX_SESSIONS"),
+				Destination: &args.sshMaxSessions,
+			},
+			&cli.Uint64Flag{
+				Name:        "ssh-max-user-sessions",
+				Usage:       "Maximum number of concurrent SSH sessions for each key, or each user name without --ssh-authorized-keys. Set to 0 for no limit.",
+				Value:       0,
+				Sources:     cli.EnvVars("SSH_MAX_USER_SESSIONS"),
+				Destination: &args.sshMaxUser,
+			},
+			&cli.DurationFlag{
+				Name:        "ssh-idle-timeout",
+				Usage:       "Disconnect SSH sessions without any input for this long, set to 0 to disable.",
+				Value:       0,
+				Sources:     cli.EnvVars("SSH_IDLE_TIMEOUT"),
+				Destination: &args.sshIdleTimeout,
+			},
+			&cli.StringFlag{
+				Name:        "audit-log",
+				Usage:       "Path to the audit log of the actions taken through the UI. Defaults to ~/.config/nodeui/audit.log.",
+				Value:       "",
+				Sources:     cli.EnvVars("AUDIT_LOG"),
+				Destination: &args.auditLogPath,
+			},
 			&cli.BoolFlag{
 				Name:        "version",
 				Aliases:     []string{"v"},
@@ -105,7 +284,7 @@ func makeCommand() *cli.Command {
 	}
 }
 
-func getRequestorOrExit(algodDataDir, url, token string) *messages.Requestor {
+func getRequestorOrExit(algodDataDir, url, token, adminToken string) *messages.Requestor {
 	// Initialize from -d, ALGORAND_DATA, or provided URL/Token
 
 	if algodDataDir != "" && (url != "" || token != "") {
@@ -143,6 +322,14 @@ func getRequestorOrExit(algodDataDir, url, token string) *messages.Requestor {
 			os.Exit(1)
 		}
 		token = string(tokenBytes)
+
+		// The admin token is optional, it enables participation key and catchup features.
+		if adminToken == "" {
+			adminTokenBytes, err := os.ReadFile(filepath.Join(algodDataDir, "algod.admin.token"))
+			if err == nil {
+				adminToken = strings.TrimSpace(string(adminTokenBytes))
+			}
+		}
 	}
 
 	if !strings.HasPrefix(url, "http") {
@@ -160,12 +347,116 @@ func getRequestorOrExit(algodDataDir, url, token string) *messages.Requestor {
 		os.Exit(1)
 	}
 
-	return messages.MakeRequestor(client, algodDataDir)
+	return messages.MakeRequestor(client, url, adminToken, algodDataDir)
+}
+
+func getReferenceOrExit(url, token string) *algod.Client {
+	if url == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(url, "http") {
+		url = "http://" + url
+	}
+
+	client, err := algod.MakeClient(url, token)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Problem creating reference client connection: %s\n", err.Error())
+		os.Exit(1)
+	}
+	return client
+}
+
+func getConfigOrExit(path string) *config.Config {
+	if path == "" {
+		var err error
+		path, err = config.DefaultPath()
+		if err != nil {
+			// Settings are optional, continue without them.
+			return &config.Config{}
+		}
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %s\n", err.Error())
+		os.Exit(1)
+	}
+	return cfg
+}
+
+// openDefaultAuditLog opens the audit log next to the default config file.
+func openDefaultAuditLog() (*messages.AuditLog, error) {
+	configPath, err := config.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(configPath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return messages.OpenAuditLog(filepath.Join(dir, "audit.log"))
 }
 
-func getAddressesOrExit(addrs []string) (result []types.Address) {
+func getAuditLogOrExit(path string) *messages.AuditLog {
+	if path == "" {
+		// Continue without an audit log when the default location is not
+		// writable, for example in a container.
+		audit, err := openDefaultAuditLog()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "No audit log, set one with --audit-log: %s\n", err.Error())
+			return nil
+		}
+		return audit
+	}
+
+	audit, err := messages.OpenAuditLog(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open audit log: %s\n", err.Error())
+		os.Exit(1)
+	}
+	return audit
+}
+
+func getAddressBookOrExit(path string) *config.AddressBook {
+	if path == "" {
+		return nil
+	}
+
+	book, err := config.LoadAddressBook(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load address book: %s\n", err.Error())
+		os.Exit(1)
+	}
+	return book
+}
+
+// mergeWatchList combines the config watch list with addresses from the
+// command line, skipping duplicates. The address book only provides labels.
+func mergeWatchList(watchList []config.Account, addrs []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, acct := range watchList {
+		seen[acct.Address] = true
+		result = append(result, acct.Address)
+	}
+	for _, addr := range addrs {
+		if !seen[addr] {
+			seen[addr] = true
+			result = append(result, addr)
+		}
+	}
+	return result
+}
+
+// getAddressesOrExit decodes the watch list, resolving address book labels.
+func getAddressesOrExit(addrs []string, book *config.AddressBook) (result []types.Address) {
 	failed := false
 	for _, addr := range addrs {
+		if labeled, ok := book.Lookup(addr); ok {
+			result = append(result, labeled)
+			continue
+		}
 		converted, err := types.DecodeAddress(addr)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to decode address '%s': %s\n", addr, err.Error())
@@ -180,3 +471,24 @@ func getAddressesOrExit(addrs []string) (result []types.Address) {
 
 	return result
 }
+
+func getConsensusOrExit(consensusFile, algodDataDir string) messages.ConsensusTable {
+	// The data directory override is optional.
+	if consensusFile == "" && algodDataDir != "" {
+		path := filepath.Join(algodDataDir, "consensus.json")
+		if _, err := os.Stat(path); err == nil {
+			consensusFile = path
+		}
+	}
+
+	if consensusFile == "" {
+		return nil
+	}
+
+	table, err := messages.LoadConsensusTable(consensusFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load consensus file: %s\n", err.Error())
+		os.Exit(1)
+	}
+	return table
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// AddressBookEntry is a labeled account.
+type AddressBookEntry struct {
+	Label   string `json:"label"`
+	Address string `json:"address"`
+}
+
+// AddressBook maps labels to accounts. A nil AddressBook is empty.
+type AddressBook struct {
+	// Entries are in file order, JSON objects are sorted by label.
+	Entries []AddressBookEntry
+
+	labels    map[types.Address]string
+	addresses map[string]types.Address
+}
+
+// LoadAddressBook reads a label to address file. JSON files hold an object of
+// label to address, or a list of {"label", "address"} entries. Other files are
+// read as CSV with label,address rows and an optional header.
+func LoadAddressBook(path string) (*AddressBook, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []AddressBookEntry
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		entries, err = readJSONAddressBook(f)
+	} else {
+		entries, err = readCSVAddressBook(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse address book (%s): %w", path, err)
+	}
+
+	book := &AddressBook{
+		labels:    make(map[types.Address]string),
+		addresses: make(map[string]types.Address),
+	}
+	for _, entry := range entries {
+		entry.Label = strings.TrimSpace(entry.Label)
+		entry.Address = strings.TrimSpace(entry.Address)
+		addr, err := types.DecodeAddress(entry.Address)
+		if err != nil {
+			return nil, fmt.Errorf("address book entry '%s' (%s): %w", entry.Label, path, err)
+		}
+		if entry.Label == "" {
+			return nil, fmt.Errorf("address book entry %s (%s) has no label", entry.Address, path)
+		}
+		if _, ok := book.addresses[entry.Label]; ok {
+			return nil, fmt.Errorf("duplicate address book label '%s' (%s)", entry.Label, path)
+		}
+		book.Entries = append(book.Entries, entry)
+		book.labels[addr] = entry.Label
+		book.addresses[entry.Label] = addr
+	}
+	return book, nil
+}
+
+func readJSONAddressBook(r io.Reader) ([]AddressBookEntry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []AddressBookEntry
+	if err := json.Unmarshal(data, &list); err == nil {
+		return list, nil
+	}
+
+	var object map[string]string
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, errors.New("expected an object of label to address or a list of entries")
+	}
+	for label, address := range object {
+		list = append(list, AddressBookEntry{Label: label, Address: address})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Label < list[j].Label })
+	return list, nil
+}
+
+func readCSVAddressBook(r io.Reader) ([]AddressBookEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []AddressBookEntry
+	for i, record := range records {
+		// Skip the optional header.
+		if i == 0 && strings.EqualFold(record[0], "label") && strings.EqualFold(record[1], "address") {
+			continue
+		}
+		entries = append(entries, AddressBookEntry{Label: record[0], Address: record[1]})
+	}
+	return entries, nil
+}
+
+// Label returns the label of an address.
+func (b *AddressBook) Label(addr types.Address) (string, bool) {
+	if b == nil {
+		return "", false
+	}
+	label, ok := b.labels[addr]
+	return label, ok
+}
+
+// Lookup returns the address of a label.
+func (b *AddressBook) Lookup(label string) (types.Address, bool) {
+	if b == nil {
+		return types.Address{}, false
+	}
+	addr, ok := b.addresses[label]
+	return addr, ok
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const (
+	testAddr1 = "737777777777777777777777777777777777777777777777777UFEJ2CI"
+	testAddr2 = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"
+)
+
+func writeBook(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadAddressBook(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		data   string
+		labels []string
+	}{
+		{
+			name:   "json object",
+			file:   "book.json",
+			data:   `{"sink": "` + testAddr1 + `", "dispenser": "` + testAddr2 + `"}`,
+			labels: []string{"dispenser", "sink"},
+		},
+		{
+			name:   "json list",
+			file:   "book.JSON",
+			data:   `[{"label": "sink", "address": "` + testAddr1 + `"}, {"label": "dispenser", "address": "` + testAddr2 + `"}]`,
+			labels: []string{"sink", "dispenser"},
+		},
+		{
+			name:   "csv with header",
+			file:   "book.csv",
+			data:   "label,address\n# comment\nsink, " + testAddr1 + "\ndispenser," + testAddr2 + "\n",
+			labels: []string{"sink", "dispenser"},
+		},
+		{
+			name:   "csv without header",
+			file:   "book.txt",
+			data:   " sink ," + testAddr1 + "\n",
+			labels: []string{"sink"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			book, err := LoadAddressBook(writeBook(t, tt.file, tt.data))
+			if err != nil {
+				t.Fatalf("LoadAddressBook() error = %v", err)
+			}
+			var labels []string
+			for _, e := range book.Entries {
+				labels = append(labels, e.Label)
+			}
+			if strings.Join(labels, ",") != strings.Join(tt.labels, ",") {
+				t.Errorf("labels = %v, want %v", labels, tt.labels)
+			}
+
+			sink, _ := types.DecodeAddress(testAddr1)
+			if label, ok := book.Label(sink); !ok || label != "sink" {
+				t.Errorf("Label(%s) = %q, %v, want sink", testAddr1, label, ok)
+			}
+			if addr, ok := book.Lookup("sink"); !ok || addr != sink {
+				t.Errorf("Lookup(sink) = %s, %v, want %s", addr, ok, testAddr1)
+			}
+			if _, ok := book.Lookup("unknown"); ok {
+				t.Error("Lookup(unknown) found an address")
+			}
+		})
+	}
+}
+
+func TestLoadAddressBookErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+		want string
+	}{
+		{name: "bad address", file: "book.csv", data: "sink,NOTANADDRESS\n", want: "'sink'"},
+		{name: "no label", file: "book.csv", data: " ," + testAddr1 + "\n", want: "has no label"},
+		{name: "duplicate label", file: "book.csv", data: "sink," + testAddr1 + "\nsink," + testAddr2 + "\n", want: "duplicate"},
+		{name: "wrong columns", file: "book.csv", data: "sink," + testAddr1 + ",extra\n", want: "unable to parse"},
+		{name: "bad json", file: "book.json", data: `["sink"]`, want: "expected an object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadAddressBook(writeBook(t, tt.file, tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadAddressBook() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNilAddressBook(t *testing.T) {
+	var book *AddressBook
+	if _, ok := book.Label(types.Address{}); ok {
+		t.Error("nil book has a label")
+	}
+	if _, ok := book.Lookup("sink"); ok {
+		t.Error("nil book has an address")
+	}
+}
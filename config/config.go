@@ -0,0 +1,179 @@
+// Package config loads and saves the node UI settings file.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Account is a watched account with an optional human readable label.
+type Account struct {
+	Address string `yaml:"address"`
+	Label   string `yaml:"label,omitempty"`
+}
+
+// RefreshRates controls how often node information is polled.
+type RefreshRates struct {
+	Status   time.Duration `yaml:"status,omitempty"`
+	Accounts time.Duration `yaml:"accounts,omitempty"`
+}
+
+// AlertRule triggers a notification for watched accounts.
+type AlertRule struct {
+	// Type is one of balance-below, outgoing-payment, rekeyed, offline or
+	// asset-changed.
+	Type string `yaml:"type"`
+	// Account limits the rule to one address, empty applies it to all watched
+	// accounts.
+	Account string `yaml:"account,omitempty"`
+	// Algos is the threshold of balance-below rules.
+	Algos float64 `yaml:"algos,omitempty"`
+	// Asset is the asset ID of asset-changed rules.
+	Asset uint64 `yaml:"asset,omitempty"`
+}
+
+// Alerts configures the account alert rules and where alerts are sent.
+type Alerts struct {
+	Rules []AlertRule `yaml:"rules,omitempty"`
+	// Cooldown is the minimum time between alerts for the same rule and account.
+	Cooldown time.Duration `yaml:"cooldown,omitempty"`
+	// Command is run for each alert with the alert as JSON on stdin.
+	Command string `yaml:"command,omitempty"`
+	// Webhook receives each alert as a JSON POST.
+	Webhook string `yaml:"webhook,omitempty"`
+}
+
+// Settings are the UI settings saved in the config file.
+type Settings struct {
+	WatchList  []Account    `yaml:"watch-list,omitempty"`
+	Refresh    RefreshRates `yaml:"refresh,omitempty"`
+	DefaultTab string       `yaml:"default-tab,omitempty"`
+	Theme      string       `yaml:"theme,omitempty"`
+	// BalanceHistory is how many balance changes are kept for each account.
+	BalanceHistory int    `yaml:"balance-history,omitempty"`
+	Alerts         Alerts `yaml:"alerts,omitempty"`
+}
+
+// Config holds the persisted UI settings.
+type Config struct {
+	Settings
+
+	// path is where the config is saved, and mu guards concurrent saves from
+	// multiple SSH sessions.
+	path string
+	mu   sync.Mutex
+}
+
+// DefaultPath returns the default config file location, typically
+// ~/.config/nodeui/config.yaml.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "nodeui", "config.yaml"), nil
+}
+
+// Load reads the config file at path. A missing file is not an error, an
+// empty config is returned which will be created on the first save.
+func Load(path string) (*Config, error) {
+	cfg := &Config{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, &cfg.Settings); err != nil {
+		return nil, fmt.Errorf("unable to parse config file (%s): %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Path returns the location of the config file.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Labels returns the account labels keyed by address.
+func (c *Config) Labels() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	labels := make(map[string]string)
+	for _, acct := range c.WatchList {
+		if acct.Label != "" {
+			labels[acct.Address] = acct.Label
+		}
+	}
+	return labels
+}
+
+// UpdateWatchList applies a change to the current watch list and saves the
+// config. Each SSH session applies its own edits, so sessions do not overwrite
+// each other's changes.
+func (c *Config) UpdateWatchList(update func([]Account) []Account) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.WatchList = update(append([]Account{}, c.WatchList...))
+	return c.save()
+}
+
+// save writes the config atomically by renaming a temporary file over the
+// previous version. The caller must hold the lock.
+func (c *Config) save() error {
+	if c.path == "" {
+		return fmt.Errorf("no config file path")
+	}
+
+	// Only the settings are marshalled, copying the Config would copy the
+	// lock.
+	data, err := yaml.Marshal(c.Settings)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(c.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return WriteFileAtomic(c.path, data, 0600)
+}
+
+// WriteFileAtomic writes data to a temporary file in the same directory and
+// renames it over path, so readers never see a partially written file.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	// Cleanup is a no-op after a successful rename.
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nodeui", "config.yaml")
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Path() != path || len(cfg.WatchList) != 0 {
+		t.Errorf("Load() = %+v, want an empty config for %s", cfg, path)
+	}
+}
+
+func TestUpdateWatchList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nodeui", "config.yaml")
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Theme = "light"
+
+	// Concurrent sessions each add an account, none of the edits are lost.
+	var wg sync.WaitGroup
+	for _, acct := range []Account{{Address: testAddr1, Label: "sink"}, {Address: testAddr2}} {
+		acct := acct
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := cfg.UpdateWatchList(func(list []Account) []Account {
+				return append(list, acct)
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	saved, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.WatchList) != 2 || saved.Theme != "light" {
+		t.Fatalf("saved config = %+v, want both accounts and the theme", saved)
+	}
+	if labels := saved.Labels(); len(labels) != 1 || labels[testAddr1] != "sink" {
+		t.Errorf("Labels() = %v, want only the sink label", labels)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("config permissions = %o, want 0600", perm)
+	}
+
+	cfg.path = ""
+	if err := cfg.UpdateWatchList(func(list []Account) []Account { return list }); err == nil {
+		t.Error("expected an error without a config path")
+	}
+}
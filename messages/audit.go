@@ -0,0 +1,164 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// LocalUser is the audit user when the UI is not served over SSH.
+	LocalUser = "local"
+
+	// maxAuditRead is how much of the end of the audit log is read for the
+	// viewer.
+	maxAuditRead = 1 << 20
+)
+
+// AuditEntry is a line of the audit log.
+type AuditEntry struct {
+	Time time.Time `json:"time"`
+	// User is the SSH user, or LocalUser.
+	User        string                 `json:"user"`
+	Fingerprint string                 `json:"fingerprint,omitempty"`
+	Action      string                 `json:"action"`
+	Params      map[string]interface{} `json:"params,omitempty"`
+	// Response is the algod response, Error is set when the action failed.
+	Response string `json:"response,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AuditLog appends the actions taken through the UI to a file, one JSON
+// object per line. A nil AuditLog records nothing.
+type AuditLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+// OpenAuditLog creates the audit log file if needed and checks that it can be
+// appended to.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &AuditLog{path: path}, nil
+}
+
+// Path returns the location of the audit log.
+func (a *AuditLog) Path() string {
+	if a == nil {
+		return ""
+	}
+	return a.path
+}
+
+// Record appends an entry, the time is set when it is zero.
+func (a *AuditLog) Record(entry AuditEntry) error {
+	if a == nil {
+		return nil
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	f, err := os.OpenFile(a.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// AuditRecordedMsg reports a failure to write the audit log.
+type AuditRecordedMsg struct {
+	Entry AuditEntry
+	Err   error
+}
+
+// RecordCmd appends an entry to the audit log.
+func (a *AuditLog) RecordCmd(entry AuditEntry) tea.Cmd {
+	if a == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return AuditRecordedMsg{Entry: entry, Err: a.Record(entry)}
+	}
+}
+
+// AuditEntriesMsg has the latest audit log entries, oldest first.
+type AuditEntriesMsg struct {
+	Entries []AuditEntry
+	Err     error
+}
+
+// errNoAuditLog is returned when the audit log is disabled.
+var errNoAuditLog = errors.New("the audit log is disabled, set one with --audit-log")
+
+// readAuditLog reads the entries at the end of the audit log.
+func (a *AuditLog) readAuditLog() ([]AuditEntry, error) {
+	f, err := os.Open(a.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	start := int64(0)
+	if info.Size() > maxAuditRead {
+		start = info.Size() - maxAuditRead
+	}
+	if _, err := f.Seek(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	if start > 0 {
+		// Skip the partial first line.
+		data = data[bytes.IndexByte(data, '\n')+1:]
+	}
+
+	var entries []AuditEntry
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		var entry AuditEntry
+		if len(bytes.TrimSpace(line)) == 0 || json.Unmarshal(line, &entry) != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ReadCmd reads the latest audit log entries.
+func (a *AuditLog) ReadCmd() tea.Cmd {
+	return func() tea.Msg {
+		if a == nil {
+			return AuditEntriesMsg{Err: errNoAuditLog}
+		}
+		entries, err := a.readAuditLog()
+		return AuditEntriesMsg{Entries: entries, Err: err}
+	}
+}
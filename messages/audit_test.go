@@ -0,0 +1,101 @@
+package messages
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func readEntries(t *testing.T, a *AuditLog) []AuditEntry {
+	t.Helper()
+	msg := a.ReadCmd()().(AuditEntriesMsg)
+	if msg.Err != nil {
+		t.Fatal(msg.Err)
+	}
+	return msg.Entries
+}
+
+func TestAuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	a, err := OpenAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("audit log stat = %v %v, want it created with 0600", info, err)
+	}
+	if entries := readEntries(t, a); len(entries) != 0 {
+		t.Fatalf("new audit log has %d entries", len(entries))
+	}
+
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	first := AuditEntry{
+		Time:        at,
+		User:        "alice",
+		Fingerprint: "SHA256:abc",
+		Action:      "catchup.start",
+		Params:      map[string]interface{}{"network": "testnet"},
+		Response:    "Catchpoint operation started",
+	}
+	if err := a.Record(first); err != nil {
+		t.Fatal(err)
+	}
+	msg := a.RecordCmd(AuditEntry{User: LocalUser, Action: "shutdown", Error: "failed"})().(AuditRecordedMsg)
+	if msg.Err != nil {
+		t.Fatal(msg.Err)
+	}
+
+	entries := readEntries(t, a)
+	if len(entries) != 2 {
+		t.Fatalf("read %d entries, want 2", len(entries))
+	}
+	got := entries[0]
+	if !got.Time.Equal(at) || got.User != "alice" || got.Fingerprint != "SHA256:abc" || got.Action != "catchup.start" ||
+		got.Params["network"] != "testnet" || got.Response != first.Response {
+		t.Errorf("first entry = %+v, want %+v", got, first)
+	}
+	if entries[1].Time.IsZero() || entries[1].Action != "shutdown" || entries[1].Error != "failed" {
+		t.Errorf("second entry = %+v, want the shutdown error with the time set", entries[1])
+	}
+
+	// Opening the log again keeps the entries.
+	if a, err = OpenAuditLog(path); err != nil {
+		t.Fatal(err)
+	}
+	if entries := readEntries(t, a); len(entries) != 2 {
+		t.Errorf("read %d entries after reopening, want 2", len(entries))
+	}
+}
+
+func TestReadAuditLogSkipsPartialLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	// The first line is longer than the read, and one line is not JSON.
+	data := `{"action":"` + strings.Repeat("x", maxAuditRead) + "\"}\n" +
+		`{"action":"config.edit"}` + "\n" +
+		"not json\n" +
+		`{"action":"shutdown"}` + "\n"
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := readEntries(t, &AuditLog{path: path})
+	if len(entries) != 2 || entries[0].Action != "config.edit" || entries[1].Action != "shutdown" {
+		t.Errorf("entries = %+v, want config.edit and shutdown", entries)
+	}
+}
+
+func TestNilAuditLog(t *testing.T) {
+	var a *AuditLog
+	if err := a.Record(AuditEntry{Action: "shutdown"}); err != nil {
+		t.Errorf("Record() = %v, want nothing recorded", err)
+	}
+	if cmd := a.RecordCmd(AuditEntry{Action: "shutdown"}); cmd != nil {
+		t.Error("RecordCmd() returned a command")
+	}
+	if msg := a.ReadCmd()().(AuditEntriesMsg); !errors.Is(msg.Err, errNoAuditLog) {
+		t.Errorf("ReadCmd() error = %v, want %v", msg.Err, errNoAuditLog)
+	}
+}
@@ -0,0 +1,86 @@
+package messages
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ConsensusParams are the consensus parameters needed to follow a protocol upgrade.
+// The field names match the consensus.json override file used by algod.
+type ConsensusParams struct {
+	UpgradeVoteRounds        uint64
+	UpgradeThreshold         uint64
+	DefaultUpgradeWaitRounds uint64
+}
+
+// defaultConsensusParams are the parameters of v20 and later protocol versions.
+var defaultConsensusParams = ConsensusParams{
+	UpgradeVoteRounds:        10000,
+	UpgradeThreshold:         9000,
+	DefaultUpgradeWaitRounds: 140000,
+}
+
+// shortUpgradeWait are the parameters of v7 through v19, which switched to the
+// new protocol 10000 rounds after the vote. v20 raised the delay to 140000.
+var shortUpgradeWait = ConsensusParams{
+	UpgradeVoteRounds:        10000,
+	UpgradeThreshold:         9000,
+	DefaultUpgradeWaitRounds: 10000,
+}
+
+// builtinConsensus holds protocol versions which differ from the defaults.
+var builtinConsensus = ConsensusTable{
+	"v7":  shortUpgradeWait,
+	"v8":  shortUpgradeWait,
+	"v9":  shortUpgradeWait,
+	"v10": shortUpgradeWait,
+	"v11": shortUpgradeWait,
+	"v12": shortUpgradeWait,
+	"https://github.com/algorand/spec/tree/0c8a9dc44d7368cc266d5407b79fb3311f4fc795":            shortUpgradeWait, // v13
+	"https://github.com/algorand/spec/tree/2526b6ae062b4fe5e163e06e41e1d9b9219135a9":            shortUpgradeWait, // v14
+	"https://github.com/algorand/spec/tree/a26ed78ed8f834e2b9ccb6eb7d3ee9f629a6e622":            shortUpgradeWait, // v15
+	"https://github.com/algorand/spec/tree/22726c9dcd12d9cddce4a8bd7e8ccaa707f74101":            shortUpgradeWait, // v16
+	"https://github.com/algorandfoundation/specs/tree/5615adc36bad610c7f165fa2967f4ecfa75125f0": shortUpgradeWait, // v17
+	"https://github.com/algorandfoundation/specs/tree/6c6bd668be0ab14098e51b37e806c509f7b7e31f": shortUpgradeWait, // v18
+	"https://github.com/algorandfoundation/specs/tree/0e196e82bfd6e327994bec373c4cc81bc878ef5c": shortUpgradeWait, // v19
+}
+
+// ConsensusTable maps a protocol version to its consensus parameters.
+type ConsensusTable map[string]ConsensusParams
+
+// Params returns the parameters for a protocol version. Unset fields of an
+// override fall back to the built-in parameters of the version, and then to
+// the defaults.
+func (t ConsensusTable) Params(protocol string) ConsensusParams {
+	params := t[protocol]
+	builtin, ok := builtinConsensus[protocol]
+	if !ok {
+		builtin = defaultConsensusParams
+	}
+
+	if params.UpgradeVoteRounds == 0 {
+		params.UpgradeVoteRounds = builtin.UpgradeVoteRounds
+	}
+	if params.UpgradeThreshold == 0 {
+		params.UpgradeThreshold = builtin.UpgradeThreshold
+	}
+	if params.DefaultUpgradeWaitRounds == 0 {
+		params.DefaultUpgradeWaitRounds = builtin.DefaultUpgradeWaitRounds
+	}
+	return params
+}
+
+// LoadConsensusTable reads consensus parameter overrides from a consensus.json file.
+func LoadConsensusTable(path string) (ConsensusTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var table ConsensusTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("unable to parse consensus file (%s): %w", path, err)
+	}
+	return table, nil
+}
@@ -0,0 +1,38 @@
+package messages
+
+import "testing"
+
+func TestConsensusParams(t *testing.T) {
+	const v19 = "https://github.com/algorandfoundation/specs/tree/0e196e82bfd6e327994bec373c4cc81bc878ef5c"
+	const v20 = "https://github.com/algorandfoundation/specs/tree/4a9db6a25595c6fd097cf9cc137cc83027787eaa"
+
+	table := ConsensusTable{
+		"custom":  {UpgradeVoteRounds: 100, UpgradeThreshold: 80, DefaultUpgradeWaitRounds: 50},
+		"partial": {UpgradeVoteRounds: 100},
+		v19:       {UpgradeThreshold: 9500},
+	}
+
+	tests := []struct {
+		name     string
+		table    ConsensusTable
+		protocol string
+		want     ConsensusParams
+	}{
+		{name: "v7", protocol: "v7", want: ConsensusParams{10000, 9000, 10000}},
+		{name: "v19", protocol: v19, want: ConsensusParams{10000, 9000, 10000}},
+		{name: "v20", protocol: v20, want: ConsensusParams{10000, 9000, 140000}},
+		{name: "future", protocol: "future", want: ConsensusParams{10000, 9000, 140000}},
+		{name: "unknown", protocol: "unknown", want: ConsensusParams{10000, 9000, 140000}},
+		{name: "override", table: table, protocol: "custom", want: ConsensusParams{100, 80, 50}},
+		{name: "override unset fields", table: table, protocol: "partial", want: ConsensusParams{100, 9000, 140000}},
+		{name: "override falls back to its builtin", table: table, protocol: v19, want: ConsensusParams{10000, 9500, 10000}},
+		{name: "override falls back to builtin", table: table, protocol: "v12", want: ConsensusParams{10000, 9000, 10000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.table.Params(tt.protocol); got != tt.want {
+				t.Errorf("Params(%s) = %+v, want %+v", tt.protocol, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxDataFileSize limits the files which are read for the viewer.
+const maxDataFileSize = 16 << 20
+
+// dataFilePatterns are the data directory files which can be viewed.
+var dataFilePatterns = []struct {
+	pattern     string
+	description string
+}{
+	{"config.json", "algod settings"},
+	{"genesis.json", "network parameters and initial accounts"},
+	{"logging.config", "logging and telemetry settings"},
+	{"phonebook.json", "relay addresses used instead of DNS"},
+	{"consensus.json", "custom consensus protocols"},
+	{"kmd-v*/kmd_config.json", "kmd settings"},
+}
+
+// remoteFiles are the files which have an API equivalent.
+var remoteFiles = map[string]bool{
+	"config.json":  true,
+	"genesis.json": true,
+}
+
+// DataFile is a recognised file in the data directory.
+type DataFile struct {
+	// Name is relative to the data directory.
+	Name        string
+	Description string
+	Size        int64
+	// ModTime is zero for files from the API.
+	ModTime time.Time
+	// Source is SourceDisk or SourceAPI.
+	Source string
+}
+
+// DataFilesMsg lists the recognised files in the data directory.
+type DataFilesMsg struct {
+	Files []DataFile
+	Err   error
+}
+
+// DataFileMsg has the content of a data directory file.
+type DataFileMsg struct {
+	File    DataFile
+	Content []byte
+	Err     error
+}
+
+func listDataFiles(dataDir string) ([]DataFile, error) {
+	var files []DataFile
+	for _, p := range dataFilePatterns {
+		matches, err := filepath.Glob(filepath.Join(dataDir, p.pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			name, err := filepath.Rel(dataDir, match)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, DataFile{
+				Name:        name,
+				Description: p.description,
+				Size:        info.Size(),
+				ModTime:     info.ModTime(),
+				Source:      SourceDisk,
+			})
+		}
+	}
+	return files, nil
+}
+
+// listRemoteFiles lists the files which are available from the API.
+func (r Requestor) listRemoteFiles(ctx context.Context) ([]DataFile, error) {
+	var files []DataFile
+	var lastErr error
+	for _, p := range dataFilePatterns {
+		if !remoteFiles[p.pattern] {
+			continue
+		}
+		data, err := r.getRemoteFile(ctx, p.pattern)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		files = append(files, DataFile{
+			Name:        p.pattern,
+			Description: p.description,
+			Size:        int64(len(data)),
+			Source:      SourceAPI,
+		})
+	}
+	if len(files) == 0 {
+		return nil, lastErr
+	}
+	return files, nil
+}
+
+// GetDataFilesCmd lists the recognised files in the data directory. Without a
+// data directory the files available from the API are listed.
+func (r Requestor) GetDataFilesCmd() tea.Cmd {
+	return func() tea.Msg {
+		if r.dataDir == "" {
+			files, err := r.listRemoteFiles(context.Background())
+			return DataFilesMsg{Files: files, Err: err}
+		}
+		files, err := listDataFiles(r.dataDir)
+		return DataFilesMsg{Files: files, Err: err}
+	}
+}
+
+// GetDataFileCmd reads a file from the data directory, or from the API when
+// there is no data directory. Only recognised files can be read.
+func (r Requestor) GetDataFileCmd(name string) tea.Cmd {
+	dataDir := r.dataDir
+	return func() tea.Msg {
+		if dataDir == "" {
+			content, err := r.getRemoteFile(context.Background(), name)
+			file := DataFile{Name: name, Size: int64(len(content)), Source: SourceAPI}
+			for _, p := range dataFilePatterns {
+				if p.pattern == name {
+					file.Description = p.description
+				}
+			}
+			return DataFileMsg{File: file, Content: content, Err: err}
+		}
+		files, err := listDataFiles(dataDir)
+		if err != nil {
+			return DataFileMsg{Err: err}
+		}
+		for _, file := range files {
+			if file.Name != name {
+				continue
+			}
+			if file.Size > maxDataFileSize {
+				return DataFileMsg{File: file, Err: fmt.Errorf("%s is too large to view (%d bytes)", name, file.Size)}
+			}
+			content, err := os.ReadFile(filepath.Join(dataDir, name))
+			return DataFileMsg{File: file, Content: content, Err: err}
+		}
+		return DataFileMsg{Err: fmt.Errorf("%s is not a recognised data directory file", name)}
+	}
+}
@@ -0,0 +1,178 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// logFile and archiveFile are the algod log names, the log is renamed to
+	// the archive when it reaches LogSizeLimit.
+	logFile     = "node.log"
+	archiveFile = "node.archive.log"
+
+	// logHistory is how much of the existing log is read on the first poll.
+	logHistory = 256 << 10
+	// maxLogRead limits how much is read on each poll.
+	maxLogRead = 4 << 20
+	// maxLogLine is where longer lines are truncated.
+	maxLogLine = 64 << 10
+)
+
+// LogEntry is a line of node.log. Lines which are not JSON only have Msg and
+// Raw.
+type LogEntry struct {
+	Time     time.Time
+	Level    string
+	Msg      string
+	File     string
+	Function string
+	// Fields has every field of the line, values are strings.
+	Fields map[string]string
+	Raw    string
+}
+
+// LogPosition is how far node.log has been read.
+type LogPosition struct {
+	Offset int64
+	// file identifies the log which was read, to detect rotation.
+	file os.FileInfo
+}
+
+// LogLinesMsg has the lines written to node.log since the last position.
+type LogLinesMsg struct {
+	Entries  []LogEntry
+	Position LogPosition
+	// Rotated is set when the log was archived since the last read.
+	Rotated bool
+	Err     error
+}
+
+// parseLogLine decodes a JSON log line.
+func parseLogLine(line string) LogEntry {
+	entry := LogEntry{Msg: line, Raw: line}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return entry
+	}
+
+	entry.Fields = make(map[string]string, len(fields))
+	for name, value := range fields {
+		if s, ok := value.(string); ok {
+			entry.Fields[name] = s
+		} else {
+			entry.Fields[name] = strings.TrimSpace(fmt.Sprint(value))
+		}
+	}
+	entry.Msg = entry.Fields["msg"]
+	entry.Level = entry.Fields["level"]
+	entry.File = entry.Fields["file"]
+	entry.Function = entry.Fields["function"]
+	entry.Time, _ = time.Parse(time.RFC3339Nano, entry.Fields["time"])
+	return entry
+}
+
+// truncateLine shortens lines longer than maxLogLine.
+func truncateLine(line string) string {
+	if len(line) <= maxLogLine {
+		return line
+	}
+	return fmt.Sprintf("%s... (%d bytes truncated)", line[:maxLogLine], len(line)-maxLogLine)
+}
+
+// readLogLines reads the complete lines from offset, the returned offset is
+// after the last complete line. A line longer than maxLogRead is returned in
+// truncated parts so that it does not stop the tail.
+func readLogLines(path string, offset int64) ([]LogEntry, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, offset, err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, err
+	}
+	data, err := io.ReadAll(io.LimitReader(f, maxLogRead))
+	if err != nil {
+		return nil, offset, err
+	}
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		if len(data) < maxLogRead {
+			return nil, offset, nil
+		}
+		entry := parseLogLine(truncateLine(string(data)))
+		return []LogEntry{entry}, offset + int64(len(data)), nil
+	}
+
+	var entries []LogEntry
+	for _, line := range strings.Split(string(data[:end]), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			entries = append(entries, parseLogLine(truncateLine(line)))
+		}
+	}
+	return entries, offset + int64(end) + 1, nil
+}
+
+// tailLog reads node.log from the position. When the log was rotated the
+// rest of the archived log is read first.
+func tailLog(dataDir string, pos LogPosition) LogLinesMsg {
+	current := filepath.Join(dataDir, logFile)
+	info, err := os.Stat(current)
+	if err != nil {
+		return LogLinesMsg{Position: pos, Err: err}
+	}
+
+	var msg LogLinesMsg
+	skipFirst := false
+	switch {
+	case pos.file == nil:
+		// Start with the end of the existing log, the first line is likely
+		// incomplete.
+		if info.Size() > logHistory {
+			pos.Offset = info.Size() - logHistory
+			skipFirst = true
+		}
+	case !os.SameFile(pos.file, info) || info.Size() < pos.Offset:
+		msg.Rotated = true
+		archive := filepath.Join(dataDir, archiveFile)
+		if archived, err := os.Stat(archive); err == nil && os.SameFile(pos.file, archived) {
+			msg.Entries, _, _ = readLogLines(archive, pos.Offset)
+		}
+		pos.Offset = 0
+	}
+
+	entries, offset, err := readLogLines(current, pos.Offset)
+	if skipFirst && len(entries) > 0 {
+		entries = entries[1:]
+	}
+	msg.Entries = append(msg.Entries, entries...)
+	msg.Position = LogPosition{Offset: offset, file: info}
+	msg.Err = err
+	return msg
+}
+
+// errNoLog is returned when there is no data directory to read node.log from.
+var errNoLog = errors.New("node.log is only available with a data directory, start with -d")
+
+// TailLogCmd reads the lines written to node.log since the position. The zero
+// position starts near the end of the log.
+func (r Requestor) TailLogCmd(pos LogPosition) tea.Cmd {
+	dataDir := r.dataDir
+	return func() tea.Msg {
+		if dataDir == "" {
+			return LogLinesMsg{Position: pos, Err: errNoLog}
+		}
+		return tailLog(dataDir, pos)
+	}
+}
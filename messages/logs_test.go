@@ -0,0 +1,171 @@
+package messages
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLogLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		want   LogEntry
+		fields map[string]string
+	}{
+		{
+			name: "json",
+			line: `{"file":"service.go","function":"agreement.(*Service).run","level":"info","msg":"Proposal Accepted","Round":1234,"time":"2024-01-02T03:04:05.123456Z"}`,
+			want: LogEntry{
+				Level:    "info",
+				Msg:      "Proposal Accepted",
+				File:     "service.go",
+				Function: "agreement.(*Service).run",
+				Time:     time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC),
+			},
+			fields: map[string]string{"Round": "1234"},
+		},
+		{
+			name:   "nested values",
+			line:   `{"level":"warn","msg":"peers","Details":{"count":2},"Synced":false}`,
+			want:   LogEntry{Level: "warn", Msg: "peers"},
+			fields: map[string]string{"Details": "map[count:2]", "Synced": "false"},
+		},
+		{
+			name: "plain text",
+			line: "algod starting",
+			want: LogEntry{Msg: "algod starting"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseLogLine(tt.line)
+			if got.Raw != tt.line {
+				t.Errorf("Raw = %q, want the line", got.Raw)
+			}
+			if got.Level != tt.want.Level || got.Msg != tt.want.Msg || got.File != tt.want.File ||
+				got.Function != tt.want.Function || !got.Time.Equal(tt.want.Time) {
+				t.Errorf("parseLogLine() = %+v, want %+v", got, tt.want)
+			}
+			for name, want := range tt.fields {
+				if got.Fields[name] != want {
+					t.Errorf("field %s = %q, want %q", name, got.Fields[name], want)
+				}
+			}
+		})
+	}
+}
+
+func writeLog(t *testing.T, path string, lines ...string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for _, line := range lines {
+		if _, err := f.WriteString(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func messagesOf(entries []LogEntry) string {
+	msgs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		msgs = append(msgs, e.Msg)
+	}
+	return strings.Join(msgs, ",")
+}
+
+func TestTailLog(t *testing.T) {
+	dir := t.TempDir()
+	current := filepath.Join(dir, logFile)
+
+	writeLog(t, current, "one\n", "two\n", "thr")
+	msg := tailLog(dir, LogPosition{})
+	if msg.Err != nil {
+		t.Fatal(msg.Err)
+	}
+	if got := messagesOf(msg.Entries); got != "one,two" {
+		t.Errorf("first read = %s, want the complete lines one,two", got)
+	}
+
+	writeLog(t, current, "ee\n")
+	msg = tailLog(dir, msg.Position)
+	if got := messagesOf(msg.Entries); got != "three" || msg.Rotated {
+		t.Errorf("second read = %s (rotated %v), want three", got, msg.Rotated)
+	}
+
+	// algod renames the log to the archive and starts a new one.
+	writeLog(t, current, "four\n")
+	if err := os.Rename(current, filepath.Join(dir, archiveFile)); err != nil {
+		t.Fatal(err)
+	}
+	writeLog(t, current, "five\n")
+	msg = tailLog(dir, msg.Position)
+	if !msg.Rotated {
+		t.Error("rotation was not detected")
+	}
+	if got := messagesOf(msg.Entries); got != "four,five" {
+		t.Errorf("read after rotation = %s, want the rest of the archive and the new log four,five", got)
+	}
+
+	// A truncated log is read from the start.
+	if err := os.WriteFile(current, []byte("six\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	msg = tailLog(dir, msg.Position)
+	if got := messagesOf(msg.Entries); got != "six" || !msg.Rotated {
+		t.Errorf("read after truncation = %s (rotated %v), want six", got, msg.Rotated)
+	}
+}
+
+func TestTailLogHistory(t *testing.T) {
+	dir := t.TempDir()
+	line := strings.Repeat("x", 1023) + "\n"
+	writeLog(t, filepath.Join(dir, logFile), strings.Repeat(line, logHistory/len(line)+10))
+
+	msg := tailLog(dir, LogPosition{})
+	if msg.Err != nil {
+		t.Fatal(msg.Err)
+	}
+	// The first line read from the middle of the file is skipped.
+	if want := logHistory/len(line) - 1; len(msg.Entries) != want {
+		t.Errorf("read %d lines, want %d", len(msg.Entries), want)
+	}
+	for _, e := range msg.Entries {
+		if e.Msg != strings.TrimSpace(line) {
+			t.Fatalf("partial line %q", e.Msg)
+		}
+	}
+}
+
+func TestTailLogLongLine(t *testing.T) {
+	dir := t.TempDir()
+	current := filepath.Join(dir, logFile)
+	writeLog(t, current, "one\n")
+	msg := tailLog(dir, LogPosition{})
+	if got := messagesOf(msg.Entries); got != "one" {
+		t.Fatalf("first read = %s, want one", got)
+	}
+
+	// A line longer than a single read, without a newline yet.
+	writeLog(t, current, strings.Repeat("x", maxLogRead+10))
+	msg = tailLog(dir, msg.Position)
+	if len(msg.Entries) != 1 || !strings.HasSuffix(msg.Entries[0].Msg, "bytes truncated)") {
+		t.Fatalf("long line read = %d entries, want one truncated line", len(msg.Entries))
+	}
+	if len(msg.Entries[0].Raw) > maxLogLine+64 {
+		t.Errorf("entry has %d bytes, want it truncated to about %d", len(msg.Entries[0].Raw), maxLogLine)
+	}
+
+	// The tail continues after the rest of the line.
+	writeLog(t, current, "\ntwo\n")
+	msg = tailLog(dir, msg.Position)
+	if got := messagesOf(msg.Entries); got != strings.Repeat("x", 10)+",two" {
+		t.Errorf("read after the long line = %.40s, want the rest of it and two", got)
+	}
+}
@@ -0,0 +1,150 @@
+package messages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/config"
+)
+
+// Sources of node files.
+const (
+	// SourceDisk is a file in the data directory.
+	SourceDisk = "disk"
+	// SourceAPI is the algod REST API, used when there is no data directory.
+	SourceAPI = "API"
+)
+
+// configPath is the algod endpoint with the node settings.
+const configPath = "/debug/settings/config"
+
+// NodeConfigMsg has the settings from the node config.json file.
+type NodeConfigMsg struct {
+	// Source is SourceDisk or SourceAPI.
+	Source string
+	// Path is the file or URL the settings were read from.
+	Path string
+	// Values are the settings in the file, numbers are json.Number.
+	Values map[string]interface{}
+	Err    error
+}
+
+// errNoDataDir is returned when writing files without a data directory.
+var errNoDataDir = errors.New("no data directory, start with -d to edit node files")
+
+// GetNodeConfigCmd reads config.json from the data directory. Without a data
+// directory the settings are requested from the algod API, which requires the
+// admin token.
+func (r Requestor) GetNodeConfigCmd() tea.Cmd {
+	return func() tea.Msg {
+		if r.dataDir == "" {
+			msg := NodeConfigMsg{Source: SourceAPI, Path: strings.TrimSuffix(r.url, "/") + configPath}
+			data, err := r.getRemoteFile(context.Background(), "config.json")
+			if err == nil {
+				msg.Values, err = decodeNodeConfig(data)
+			}
+			msg.Err = err
+			return msg
+		}
+		file := path.Join(r.dataDir, "config.json")
+		values, err := readNodeConfig(file)
+		return NodeConfigMsg{Source: SourceDisk, Path: file, Values: values, Err: err}
+	}
+}
+
+// getRemoteFile requests the API equivalent of a data directory file.
+func (r Requestor) getRemoteFile(ctx context.Context, name string) ([]byte, error) {
+	switch name {
+	case "genesis.json":
+		genesis, err := r.Client.GetGenesis().Do(ctx)
+		return []byte(genesis), err
+	case "config.json":
+		var data json.RawMessage
+		err := r.adminRequest(ctx, http.MethodGet, configPath, nil, &data)
+		return data, err
+	}
+	return nil, fmt.Errorf("%s is not available from the API", name)
+}
+
+func readNodeConfig(file string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return decodeNodeConfig(data)
+}
+
+func decodeNodeConfig(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var values map[string]interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// NodeConfigSavedMsg is the result of updating config.json.
+type NodeConfigSavedMsg struct {
+	// Updates are the settings which were changed.
+	Updates map[string]interface{}
+	// Backup is the copy of the previous config.json, it is empty when there
+	// was no file.
+	Backup string
+	Err    error
+}
+
+// SetNodeConfigCmd applies the updates to config.json in the data directory.
+// The previous file is backed up with a timestamp, and the new file is
+// written atomically.
+func (r Requestor) SetNodeConfigCmd(updates map[string]interface{}) tea.Cmd {
+	dataDir := r.dataDir
+	return func() tea.Msg {
+		if dataDir == "" {
+			return NodeConfigSavedMsg{Updates: updates, Err: errNoDataDir}
+		}
+		backup, err := writeNodeConfig(path.Join(dataDir, "config.json"), updates)
+		return NodeConfigSavedMsg{Updates: updates, Backup: backup, Err: err}
+	}
+}
+
+func writeNodeConfig(file string, updates map[string]interface{}) (string, error) {
+	values, err := readNodeConfig(file)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	if values == nil {
+		values = make(map[string]interface{})
+	}
+	for name, value := range updates {
+		values[name] = value
+	}
+	data, err := json.MarshalIndent(values, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	var backup string
+	perm := os.FileMode(0644)
+	if previous, err := os.ReadFile(file); err == nil {
+		if info, err := os.Stat(file); err == nil {
+			perm = info.Mode().Perm()
+		}
+		backup = fmt.Sprintf("%s.%s.bak", file, time.Now().Format("20060102-150405.000"))
+		if err := os.WriteFile(backup, previous, perm); err != nil {
+			return "", fmt.Errorf("unable to back up config.json: %w", err)
+		}
+	}
+	return backup, config.WriteFileAtomic(file, append(data, '\n'), perm)
+}
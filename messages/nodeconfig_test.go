@@ -0,0 +1,109 @@
+package messages
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteNodeConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		updates  map[string]interface{}
+		want     map[string]string
+	}{
+		{
+			name:    "new file",
+			updates: map[string]interface{}{"Version": 33, "Archival": true},
+			want:    map[string]string{"Version": "33", "Archival": "true"},
+		},
+		{
+			name:     "keeps other settings",
+			existing: `{"Version": 27, "GossipFanout": 8, "NetAddress": ":4160"}`,
+			updates:  map[string]interface{}{"GossipFanout": int64(4)},
+			want:     map[string]string{"Version": "27", "GossipFanout": "4", "NetAddress": `":4160"`},
+		},
+		{
+			name:     "keeps large numbers",
+			existing: `{"Version": 33, "MaxAPIResourcesPerAccount": 18446744073709551615}`,
+			updates:  map[string]interface{}{"CatchupParallelBlocks": uint64(18446744073709551615)},
+			want: map[string]string{
+				"Version":                   "33",
+				"MaxAPIResourcesPerAccount": "18446744073709551615",
+				"CatchupParallelBlocks":     "18446744073709551615",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "config.json")
+			if tt.existing != "" {
+				if err := os.WriteFile(file, []byte(tt.existing), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			backup, err := writeNodeConfig(file, tt.updates)
+			if err != nil {
+				t.Fatalf("writeNodeConfig() error = %v", err)
+			}
+
+			values, err := readNodeConfig(file)
+			if err != nil {
+				t.Fatalf("readNodeConfig() error = %v", err)
+			}
+			if len(values) != len(tt.want) {
+				t.Errorf("config.json has %d settings, want %d: %v", len(values), len(tt.want), values)
+			}
+			for name, want := range tt.want {
+				got, err := json.Marshal(values[name])
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != want {
+					t.Errorf("%s = %s, want %s", name, got, want)
+				}
+			}
+
+			if tt.existing == "" {
+				if backup != "" {
+					t.Errorf("backup = %s, want none for a new file", backup)
+				}
+				return
+			}
+			previous, err := os.ReadFile(backup)
+			if err != nil {
+				t.Fatalf("reading the backup: %v", err)
+			}
+			if string(previous) != tt.existing {
+				t.Errorf("backup = %s, want %s", previous, tt.existing)
+			}
+			info, err := os.Stat(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if perm := info.Mode().Perm(); perm != 0600 {
+				t.Errorf("config.json permissions = %o, want the previous 0600", perm)
+			}
+		})
+	}
+}
+
+func TestWriteNodeConfigInvalidFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(file, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writeNodeConfig(file, map[string]interface{}{"Archival": true}); err == nil {
+		t.Fatal("expected an error for an unreadable config.json")
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "{not json" {
+		t.Errorf("config.json was modified: %s", data)
+	}
+}
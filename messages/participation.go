@@ -0,0 +1,178 @@
+package messages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ParticipationKeyInfo holds the public key material of a participation key.
+type ParticipationKeyInfo struct {
+	SelectionParticipationKey []byte `json:"selection-participation-key"`
+	StateProofKey             []byte `json:"state-proof-key,omitempty"`
+	VoteFirstValid            uint64 `json:"vote-first-valid"`
+	VoteLastValid             uint64 `json:"vote-last-valid"`
+	VoteKeyDilution           uint64 `json:"vote-key-dilution"`
+	VoteParticipationKey      []byte `json:"vote-participation-key"`
+}
+
+// ParticipationKey is a participation key installed on the node.
+type ParticipationKey struct {
+	ID                  string               `json:"id"`
+	Address             string               `json:"address"`
+	EffectiveFirstValid uint64               `json:"effective-first-valid,omitempty"`
+	EffectiveLastValid  uint64               `json:"effective-last-valid,omitempty"`
+	LastVote            uint64               `json:"last-vote,omitempty"`
+	LastBlockProposal   uint64               `json:"last-block-proposal,omitempty"`
+	LastStateProof      uint64               `json:"last-state-proof,omitempty"`
+	Key                 ParticipationKeyInfo `json:"key"`
+}
+
+// errNoAdminToken is returned by admin requests when no admin token is available.
+var errNoAdminToken = fmt.Errorf("no algod admin token, provide a data directory or --algod-admin-token")
+
+// adminRequest calls an algod endpoint which requires the admin token. When
+// out is not nil the JSON response is decoded into it.
+func (r Requestor) adminRequest(ctx context.Context, method, path string, body io.Reader, out interface{}) error {
+	if r.adminToken == "" {
+		return errNoAdminToken
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(r.url, "/")+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Algo-API-Token", r.adminToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/msgpack")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("%s %s: %s", method, path, apiErr.Message)
+		}
+		return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// getParticipationKeys lists the participation keys installed on the node.
+func (r Requestor) getParticipationKeys(ctx context.Context) ([]ParticipationKey, error) {
+	var keys []ParticipationKey
+	err := r.adminRequest(ctx, http.MethodGet, "/v2/participation", nil, &keys)
+	return keys, err
+}
+
+// ParticipationKeysMsg has the participation keys installed on the node.
+type ParticipationKeysMsg struct {
+	Keys []ParticipationKey
+	Err  error
+}
+
+// GetParticipationKeysCmd provides a tea.Cmd for fetching a ParticipationKeysMsg.
+func (r Requestor) GetParticipationKeysCmd() tea.Cmd {
+	return func() tea.Msg {
+		keys, err := r.getParticipationKeys(context.Background())
+		return ParticipationKeysMsg{Keys: keys, Err: err}
+	}
+}
+
+// ParticipationActionMsg reports the result of a participation key change.
+type ParticipationActionMsg struct {
+	// Kind is "install", "delete" or "generate".
+	Kind string
+	// Action describes the requested change, e.g. "delete key <id>".
+	Action string
+	// Params are the request parameters, Response is the algod response.
+	Params   map[string]interface{}
+	Response string
+	Err      error
+}
+
+// InstallParticipationKeyCmd installs a participation key file from disk.
+// The file is read from the machine running the UI.
+func (r Requestor) InstallParticipationKeyCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		msg := ParticipationActionMsg{
+			Kind:   "install",
+			Action: fmt.Sprintf("install key file %s", path),
+			Params: map[string]interface{}{"path": path},
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+
+		var resp struct {
+			PartID string `json:"partId"`
+		}
+		msg.Err = r.adminRequest(context.Background(), http.MethodPost, "/v2/participation", bytes.NewReader(data), &resp)
+		if msg.Err == nil {
+			msg.Action = fmt.Sprintf("install key file %s as %s", path, resp.PartID)
+			msg.Response = "partId " + resp.PartID
+		}
+		return msg
+	}
+}
+
+// DeleteParticipationKeyCmd deletes a participation key by ID.
+func (r Requestor) DeleteParticipationKeyCmd(id string) tea.Cmd {
+	return func() tea.Msg {
+		err := r.adminRequest(context.Background(), http.MethodDelete, "/v2/participation/"+url.PathEscape(id), nil, nil)
+		return ParticipationActionMsg{
+			Kind:   "delete",
+			Action: fmt.Sprintf("delete key %s", id),
+			Params: map[string]interface{}{"id": id},
+			Err:    err,
+		}
+	}
+}
+
+// GenerateParticipationKeysCmd asks the node to generate and install a
+// participation key for the address. A zero dilution uses the node default.
+// Generation runs in the background on the node, the new key appears once it
+// completes.
+func (r Requestor) GenerateParticipationKeysCmd(address string, first, last, dilution uint64) tea.Cmd {
+	return func() tea.Msg {
+		query := url.Values{}
+		query.Set("first", fmt.Sprintf("%d", first))
+		query.Set("last", fmt.Sprintf("%d", last))
+		if dilution != 0 {
+			query.Set("dilution", fmt.Sprintf("%d", dilution))
+		}
+		path := fmt.Sprintf("/v2/participation/generate/%s?%s", url.PathEscape(address), query.Encode())
+		err := r.adminRequest(context.Background(), http.MethodPost, path, nil, nil)
+		return ParticipationActionMsg{
+			Kind:   "generate",
+			Action: fmt.Sprintf("request key generation for %s, rounds %d-%d", address, first, last),
+			Params: map[string]interface{}{"address": address, "first": first, "last": last, "dilution": dilution},
+			Err:    err,
+		}
+	}
+}
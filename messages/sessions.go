@@ -0,0 +1,37 @@
+package messages
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Session is an SSH session connected to the UI.
+type Session struct {
+	User string
+	// Fingerprint is the SHA256 fingerprint of the client key, it is empty
+	// without public key authentication.
+	Fingerprint string
+	Role        string
+	RemoteAddr  string
+	Since       time.Time
+	LastInput   time.Time
+}
+
+// SessionsMsg lists the active SSH sessions. Serving is false when the UI is
+// not served over SSH.
+type SessionsMsg struct {
+	Sessions []Session
+	Serving  bool
+}
+
+// GetSessionsCmd lists the sessions from the source, the source is nil when
+// the UI is not served over SSH.
+func GetSessionsCmd(source func() []Session) tea.Cmd {
+	return func() tea.Msg {
+		if source == nil {
+			return SessionsMsg{}
+		}
+		return SessionsMsg{Sessions: source(), Serving: true}
+	}
+}
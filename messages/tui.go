@@ -21,9 +21,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
-	"path"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
@@ -34,18 +34,32 @@ import (
 
 // Requestor provides an opaque pointer for an algod client.
 type Requestor struct {
-	Client  *algod.Client
-	dataDir string
+	Client *algod.Client
+	// Reference is an optional node used to find the latest network round.
+	Reference *algod.Client
+	dataDir   string
+
+	// url and adminToken are used for endpoints which are not in the SDK or
+	// which require the admin token.
+	url        string
+	adminToken string
 }
 
-// MakeRequestor builds the requestor object.
-func MakeRequestor(client *algod.Client, dataDir string) *Requestor {
+// MakeRequestor builds the requestor object. The admin token is optional.
+func MakeRequestor(client *algod.Client, url, adminToken, dataDir string) *Requestor {
 	return &Requestor{
-		Client:  client,
-		dataDir: dataDir,
+		Client:     client,
+		dataDir:    dataDir,
+		url:        url,
+		adminToken: adminToken,
 	}
 }
 
+// DataDir returns the algod data directory, it is empty when using -u/-t.
+func (r Requestor) DataDir() string {
+	return r.dataDir
+}
+
 // NetworkMsg holds network information.
 type NetworkMsg struct {
 	GenesisID   string
@@ -58,7 +72,7 @@ func formatVersion(ver models.Version) string {
 	return fmt.Sprintf("%s %d.%d.%d (%s)",
 		ver.Build.Channel,
 		ver.Build.Major,
-		ver.Build.Major,
+		ver.Build.Minor,
 		ver.Build.BuildNumber,
 		ver.Build.CommitHash)
 }
@@ -107,10 +121,42 @@ func (r Requestor) GetStatusCmd() tea.Cmd {
 	}
 }
 
+// NetworkRoundMsg has the latest round reported by the reference node.
+type NetworkRoundMsg struct {
+	Round uint64
+	Err   error
+}
+
+// GetNetworkRoundCmd provides a tea.Cmd for fetching a NetworkRoundMsg.
+func (r Requestor) GetNetworkRoundCmd() tea.Cmd {
+	if r.Reference == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		resp, err := r.Reference.Status().Do(context.Background())
+		return NetworkRoundMsg{
+			Round: resp.LastRound,
+			Err:   err,
+		}
+	}
+}
+
+// BlockTimeMsg has the latest round and the average time between rounds.
+type BlockTimeMsg struct {
+	Round   uint64
+	Average time.Duration
+}
+
 // AccountStatusMsg has account balance information.
 type AccountStatusMsg struct {
 	Balances map[types.Address]map[uint64]uint64
-	Err      error
+	// Info has the full account information for each account.
+	Info map[types.Address]models.Account
+	// ParticipationKeys are the keys installed on this node, ParticipationErr
+	// is set when they could not be listed, e.g. without an admin token.
+	ParticipationKeys []ParticipationKey
+	ParticipationErr  error
+	Err               error
 }
 
 // GetAccountStatusCmd provides a tea.Cmd for fetching a AccountStatusMsg.
@@ -118,6 +164,7 @@ func (r Requestor) GetAccountStatusCmd(accounts []types.Address) tea.Cmd {
 	return func() tea.Msg {
 		var rval AccountStatusMsg
 		rval.Balances = make(map[types.Address]map[uint64]uint64)
+		rval.Info = make(map[types.Address]models.Account)
 
 		for _, acct := range accounts {
 			resp, err := r.Client.AccountInformation(acct.String()).Do(context.Background())
@@ -126,6 +173,7 @@ func (r Requestor) GetAccountStatusCmd(accounts []types.Address) tea.Cmd {
 					Err: err,
 				}
 			}
+			rval.Info[acct] = resp
 			rval.Balances[acct] = make(map[uint64]uint64)
 
 			// algos at the special index
@@ -137,73 +185,117 @@ func (r Requestor) GetAccountStatusCmd(accounts []types.Address) tea.Cmd {
 			}
 		}
 
+		if len(accounts) > 0 {
+			rval.ParticipationKeys, rval.ParticipationErr = r.getParticipationKeys(context.Background())
+		}
+
 		return rval
 	}
 }
 
-func doFastCatchupRequest(verb, network string) error {
-	resp, err := http.Get(fmt.Sprintf("https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint", network))
-	if err != nil {
-		panic(err)
-	}
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		panic(err)
+// AssetParamsMsg has the parameters of assets held by watched accounts.
+type AssetParamsMsg struct {
+	Params map[uint64]models.AssetParams
+	Err    error
+}
+
+// GetAssetParamsCmd provides a tea.Cmd for fetching a AssetParamsMsg. Assets
+// which could not be fetched, e.g. because they were destroyed, are skipped
+// and Err is the last failure.
+func (r Requestor) GetAssetParamsCmd(ids []uint64) tea.Cmd {
+	return func() tea.Msg {
+		var rval AssetParamsMsg
+		rval.Params = make(map[uint64]models.AssetParams)
+		for _, id := range ids {
+			asset, err := r.Client.GetAssetByID(id).Do(context.Background())
+			if err != nil {
+				rval.Err = err
+				continue
+			}
+			rval.Params[id] = asset.Params
+		}
+		return rval
 	}
-	catchpoint := strings.Replace(string(body), "#", "%23", 1)
+}
 
-	//start fast catchup
-	url := fmt.Sprintf("http://localhost:8080/v2/catchup/%s", catchpoint)
-	url = url[:len(url)-1] // remove \n
-	apiToken, err := os.ReadFile(path.Join(os.Getenv("ALGORAND_DATA"), "algod.admin.token"))
+// catchpointURL has the latest catchpoint of each network.
+const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
+
+// FastCatchupMsg is the result of starting or aborting a fast catchup.
+type FastCatchupMsg struct {
+	// Action is "start" or "abort".
+	Action     string
+	Network    string
+	Catchpoint string
+	// Response is the catchup message from algod.
+	Response string
+	Err      error
+}
+
+// getLatestCatchpoint fetches the latest catchpoint published for the network.
+func getLatestCatchpoint(ctx context.Context, network string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(catchpointURL, network), nil)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	req, err := http.NewRequest(verb, url, nil)
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	req.Header.Set("X-Algo-Api-Token", string(apiToken))
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{}
-	resp, err = client.Do(req)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("no catchpoint for network '%s': %s", network, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return "", err
 	}
-	defer resp.Body.Close()
+	return strings.TrimSpace(string(body)), nil
+}
 
-	return nil
+// CatchpointMsg has the latest catchpoint published for a network.
+type CatchpointMsg struct {
+	Network    string
+	Catchpoint string
+	Err        error
 }
 
-// StartFastCatchup attempts to start fast catchup for a given network.
-func StartFastCatchup(network string) tea.Cmd {
+// GetLatestCatchpointCmd fetches the latest catchpoint for the network.
+func GetLatestCatchpointCmd(network string) tea.Cmd {
 	return func() tea.Msg {
-		err := doFastCatchupRequest(http.MethodPost, network)
-		if err != nil {
-			panic(err)
-		}
-		return nil
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		catchpoint, err := getLatestCatchpoint(ctx, network)
+		return CatchpointMsg{Network: network, Catchpoint: catchpoint, Err: err}
 	}
 }
 
-// StopFastCatchup attempts to stop fast catchup for a given network.
-func StopFastCatchup(network string) tea.Cmd {
+// fastCatchup starts or aborts a fast catchup to the catchpoint. It uses the
+// algod URL and admin token of the requestor, like the participation key
+// actions.
+func (r Requestor) fastCatchup(method, action, network, catchpoint string) FastCatchupMsg {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	msg := FastCatchupMsg{Action: action, Network: network, Catchpoint: catchpoint}
+	var resp struct {
+		Message string `json:"catchup-message"`
+	}
+	msg.Err = r.adminRequest(ctx, method, "/v2/catchup/"+url.PathEscape(catchpoint), nil, &resp)
+	msg.Response = resp.Message
+	return msg
+}
+
+// StartFastCatchupCmd attempts to start fast catchup to a catchpoint.
+func (r Requestor) StartFastCatchupCmd(network, catchpoint string) tea.Cmd {
 	return func() tea.Msg {
-		err := doFastCatchupRequest(http.MethodDelete, network)
-		if err != nil {
-			panic(err)
-		}
-		return nil
+		return r.fastCatchup(http.MethodPost, "start", network, catchpoint)
 	}
 }
 
-// GetConfigs returns the node config.json file if possible.
-func GetConfigs() string {
-	// TODO: Optional
-	configs, err := os.ReadFile(path.Join(os.Getenv("ALGORAND_DATA"), "config.json"))
-	if err != nil {
-		return "config.json file not found"
+// StopFastCatchupCmd attempts to stop the fast catchup to a catchpoint.
+func (r Requestor) StopFastCatchupCmd(network, catchpoint string) tea.Cmd {
+	return func() tea.Msg {
+		return r.fastCatchup(http.MethodDelete, "abort", network, catchpoint)
 	}
-	return string(configs)
 }
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/ssh"
+)
+
+// Role is the access level of an SSH user.
+type Role string
+
+const (
+	// RoleViewer can watch the node, it is the default role.
+	RoleViewer Role = "viewer"
+	// RoleOperator can also start fast catchups, edit the node config and
+	// manage participation keys.
+	RoleOperator Role = "operator"
+)
+
+// authorizedKey is a public key allowed to connect.
+type authorizedKey struct {
+	key     ssh.PublicKey
+	role    Role
+	comment string
+}
+
+// parseRole reads the role from the authorized_keys options, for example
+// role=operator or role="viewer".
+func parseRole(options []string) (Role, error) {
+	role := RoleViewer
+	for _, option := range options {
+		name, value, ok := strings.Cut(option, "=")
+		if !ok || !strings.EqualFold(name, "role") {
+			continue
+		}
+		switch Role(strings.ToLower(strings.Trim(value, `"`))) {
+		case RoleViewer:
+			role = RoleViewer
+		case RoleOperator:
+			role = RoleOperator
+		default:
+			return "", fmt.Errorf("unknown role '%s', expected %s or %s", value, RoleViewer, RoleOperator)
+		}
+	}
+	return role, nil
+}
+
+// loadAuthorizedKeys reads an OpenSSH authorized_keys file. Each key may set
+// its role with a role=viewer or role=operator option.
+func loadAuthorizedKeys(path string) ([]authorizedKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []authorizedKey
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		key, comment, options, _, err := ssh.ParseAuthorizedKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+		role, err := parseRole(options)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+		keys = append(keys, authorizedKey{key: key, role: role, comment: comment})
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%s: no keys found", path)
+	}
+	return keys, nil
+}
+
+// findKey returns the authorized key matching the public key.
+func findKey(keys []authorizedKey, key ssh.PublicKey) (authorizedKey, bool) {
+	for _, k := range keys {
+		if ssh.KeysEqual(k.key, key) {
+			return k, true
+		}
+	}
+	return authorizedKey{}, false
+}
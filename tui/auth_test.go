@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		options []string
+		want    Role
+		wantErr bool
+	}{
+		{want: RoleViewer},
+		{options: []string{"no-pty"}, want: RoleViewer},
+		{options: []string{"role=operator"}, want: RoleOperator},
+		{options: []string{`role="viewer"`}, want: RoleViewer},
+		{options: []string{"ROLE=Operator"}, want: RoleOperator},
+		{options: []string{"no-port-forwarding", `role="operator"`}, want: RoleOperator},
+		{options: []string{"role=admin"}, wantErr: true},
+		{options: []string{"role="}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseRole(tt.options)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseRole(%q) error = %v, wantErr %v", tt.options, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseRole(%q) = %s, want %s", tt.options, got, tt.want)
+		}
+	}
+}
+
+func newPublicKey(t *testing.T) gossh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := gossh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func authorizedLine(key gossh.PublicKey) string {
+	return strings.TrimSpace(string(gossh.MarshalAuthorizedKey(key)))
+}
+
+func TestLoadAuthorizedKeys(t *testing.T) {
+	operator, viewer, unknown := newPublicKey(t), newPublicKey(t), newPublicKey(t)
+	path := filepath.Join(t.TempDir(), "authorized_keys")
+	data := strings.Join([]string{
+		"# node operators",
+		`role="operator" ` + authorizedLine(operator) + " alice@laptop",
+		"",
+		authorizedLine(viewer) + " bob",
+	}, "\n")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := loadAuthorizedKeys(path)
+	if err != nil {
+		t.Fatalf("loadAuthorizedKeys() error = %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("loaded %d keys, want 2", len(keys))
+	}
+
+	tests := []struct {
+		name    string
+		key     gossh.PublicKey
+		found   bool
+		role    Role
+		comment string
+	}{
+		{name: "operator", key: operator, found: true, role: RoleOperator, comment: "alice@laptop"},
+		{name: "viewer", key: viewer, found: true, role: RoleViewer, comment: "bob"},
+		{name: "unknown", key: unknown},
+	}
+	for _, tt := range tests {
+		got, ok := findKey(keys, tt.key)
+		if ok != tt.found {
+			t.Errorf("%s: found = %v, want %v", tt.name, ok, tt.found)
+			continue
+		}
+		if got.role != tt.role || got.comment != tt.comment {
+			t.Errorf("%s: role %s, comment %q, want %s, %q", tt.name, got.role, got.comment, tt.role, tt.comment)
+		}
+	}
+}
+
+func TestLoadAuthorizedKeysErrors(t *testing.T) {
+	key := authorizedLine(newPublicKey(t))
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "empty", data: "# no keys\n", want: "no keys found"},
+		{name: "bad key", data: key + "\nssh-ed25519 AAAA\n", want: ":2:"},
+		{name: "bad role", data: "role=root " + key + "\n", want: "unknown role"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "authorized_keys")
+			if err := os.WriteFile(path, []byte(tt.data), 0600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := loadAuthorizedKeys(path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("loadAuthorizedKeys() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+	if _, err := loadAuthorizedKeys(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
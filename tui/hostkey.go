@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/keygen"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// defaultHostKey is the host key path under the home directory.
+const defaultHostKey = ".ssh/term_info_ed25519"
+
+// hostKeyPath returns the configured host key path, or the default path in
+// the home directory.
+func hostKeyPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("no home directory for the default host key, set one with --ssh-host-key: %w", err)
+	}
+	return filepath.Join(home, defaultHostKey), nil
+}
+
+// writeNewFile creates a file which must not exist yet.
+func writeNewFile(path string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// generateHostKey writes a new ed25519 key to path, readable only by the
+// owner, and its public key to path.pub. Missing directories are created
+// for the owner only, existing ones are left as they are.
+func generateHostKey(path string) ([]byte, error) {
+	pair, err := keygen.New("", keygen.WithKeyType(keygen.Ed25519))
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	pemBytes := pair.RawPrivateKey()
+	if err := writeNewFile(path, pemBytes, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path+".pub", []byte(pair.AuthorizedKey()+"\n"), 0644); err != nil {
+		return nil, err
+	}
+	return pemBytes, nil
+}
+
+// loadHostKey reads the SSH host key, generating it when it does not exist.
+// The key fingerprint is logged so that clients can verify it.
+func loadHostKey(path string) ([]byte, error) {
+	pemBytes, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		pemBytes, err = generateHostKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate host key %s: %w", path, err)
+		}
+		log.Printf("Generated SSH host key %s", path)
+	case err != nil:
+		return nil, fmt.Errorf("unable to read host key: %w", err)
+	default:
+		if info, err := os.Stat(path); err == nil && info.Mode().Perm()&0077 != 0 {
+			log.Printf("WARNING: SSH host key %s is accessible by other users (%s), it should be 0600", path, info.Mode().Perm())
+		}
+	}
+
+	signer, err := gossh.ParsePrivateKey(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host key %s: %w", path, err)
+	}
+	log.Printf("SSH host key %s fingerprint %s", signer.PublicKey().Type(), gossh.FingerprintSHA256(signer.PublicKey()))
+	return pemBytes, nil
+}
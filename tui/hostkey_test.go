@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestHostKeyPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	got, err := hostKeyPath("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(home, defaultHostKey); got != want {
+		t.Errorf("default path = %s, want %s", got, want)
+	}
+
+	got, err = hostKeyPath("/etc/nodeui/host_key")
+	if err != nil || got != "/etc/nodeui/host_key" {
+		t.Errorf("configured path = %s %v, want /etc/nodeui/host_key", got, err)
+	}
+}
+
+func TestLoadHostKeyGenerates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys", "host_ed25519")
+
+	generated, err := loadHostKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := gossh.ParsePrivateKey(generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.PublicKey().Type() != gossh.KeyAlgoED25519 {
+		t.Errorf("key type = %s, want ed25519", signer.PublicKey().Type())
+	}
+
+	for file, want := range map[string]os.FileMode{
+		filepath.Dir(path): 0700,
+		path:               0600,
+		path + ".pub":      0644,
+	} {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != want {
+			t.Errorf("%s mode = %s, want %s", file, info.Mode().Perm(), want)
+		}
+	}
+
+	// The key is read back instead of being generated again.
+	loaded, err := loadHostKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(loaded, generated) {
+		t.Error("loading the host key again returned a different key")
+	}
+}
+
+func TestLoadHostKeyInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "host_ed25519")
+	if err := os.WriteFile(path, []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadHostKey(path); err == nil {
+		t.Error("loaded an invalid host key")
+	}
+}
+
+func TestWriteNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	if err := writeNewFile(path, []byte("first"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeNewFile(path, []byte("second"), 0600); !errors.Is(err, fs.ErrExist) {
+		t.Errorf("overwriting error = %v, want it to exist", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "first" {
+		t.Errorf("file = %s, want it unchanged", data)
+	}
+}
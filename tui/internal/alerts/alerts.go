@@ -0,0 +1,230 @@
+// Package alerts evaluates the watched account alert rules and sends the
+// resulting notifications.
+package alerts
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/config"
+)
+
+// Rule types.
+const (
+	BalanceBelow    = "balance-below"
+	OutgoingPayment = "outgoing-payment"
+	Rekeyed         = "rekeyed"
+	Offline         = "offline"
+	AssetChanged    = "asset-changed"
+)
+
+// defaultCooldown is used when no cooldown is configured.
+const defaultCooldown = 5 * time.Minute
+
+// maxRecent is how many alerts are kept for the notification list.
+const maxRecent = 50
+
+// Alert is a triggered rule.
+type Alert struct {
+	Time    time.Time `json:"time"`
+	Rule    string    `json:"rule"`
+	Account string    `json:"account"`
+	Message string    `json:"message"`
+}
+
+func (a Alert) String() string {
+	return fmt.Sprintf("%s %s: %s", a.Time.Format("15:04:05"), a.Account, a.Message)
+}
+
+// Payment is an outgoing transfer from a watched account seen in a block.
+type Payment struct {
+	Account  types.Address
+	Round    uint64
+	Type     types.TxType
+	Receiver string
+	// Amount is the formatted amount including the unit.
+	Amount string
+}
+
+// rule is a validated config.AlertRule.
+type rule struct {
+	config.AlertRule
+	// account is zero when the rule applies to all accounts.
+	account types.Address
+}
+
+func (r rule) applies(addr types.Address) bool {
+	return r.account == types.Address{} || r.account == addr
+}
+
+// cooldownKey identifies the rule and account for the cooldown.
+type cooldownKey struct {
+	rule    int
+	account types.Address
+}
+
+// Engine evaluates the alert rules. It is shared by all SSH sessions, so
+// alerts are deduplicated and hooks only run once.
+type Engine struct {
+	rules    []rule
+	cooldown time.Duration
+	command  string
+	webhook  string
+
+	mu       sync.Mutex
+	last     map[cooldownKey]time.Time
+	previous map[types.Address]models.Account
+	balances map[types.Address]map[uint64]uint64
+	round    uint64
+	recent   []Alert
+	hookErr  error
+}
+
+// New validates the alert settings and creates an Engine.
+func New(settings config.Alerts) (*Engine, error) {
+	e := &Engine{
+		cooldown: settings.Cooldown,
+		command:  settings.Command,
+		webhook:  settings.Webhook,
+		last:     make(map[cooldownKey]time.Time),
+		previous: make(map[types.Address]models.Account),
+		balances: make(map[types.Address]map[uint64]uint64),
+	}
+	if e.cooldown <= 0 {
+		e.cooldown = defaultCooldown
+	}
+
+	for i, r := range settings.Rules {
+		validated := rule{AlertRule: r}
+		switch r.Type {
+		case BalanceBelow, OutgoingPayment, Rekeyed, Offline:
+		case AssetChanged:
+			if r.Asset == 0 {
+				return nil, fmt.Errorf("alert rule %d: %s requires an asset", i+1, r.Type)
+			}
+		default:
+			return nil, fmt.Errorf("alert rule %d: unknown type '%s'", i+1, r.Type)
+		}
+		if r.Account != "" {
+			addr, err := types.DecodeAddress(r.Account)
+			if err != nil {
+				return nil, fmt.Errorf("alert rule %d: invalid account: %w", i+1, err)
+			}
+			validated.account = addr
+		}
+		e.rules = append(e.rules, validated)
+	}
+	return e, nil
+}
+
+// fire records an alert unless the rule is cooling down for the account. The
+// caller must hold the lock.
+func (e *Engine) fire(index int, addr types.Address, now time.Time, message string) []Alert {
+	key := cooldownKey{rule: index, account: addr}
+	if last, ok := e.last[key]; ok && now.Sub(last) < e.cooldown {
+		return nil
+	}
+	e.last[key] = now
+
+	alert := Alert{Time: now, Rule: e.rules[index].Type, Account: addr.String(), Message: message}
+	e.recent = append([]Alert{alert}, e.recent...)
+	if len(e.recent) > maxRecent {
+		e.recent = e.recent[:maxRecent]
+	}
+	return []Alert{alert}
+}
+
+// ObserveAccounts evaluates the account rules against the latest account
+// information and returns the new alerts. Transitions are only detected once
+// an account has been seen before.
+func (e *Engine) ObserveAccounts(info map[types.Address]models.Account, balances map[types.Address]map[uint64]uint64) []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	var alerts []Alert
+	for addr, acct := range info {
+		prev, seen := e.previous[addr]
+		prevBalances := e.balances[addr]
+		for i, r := range e.rules {
+			if !r.applies(addr) {
+				continue
+			}
+			switch r.Type {
+			case BalanceBelow:
+				threshold := uint64(r.Algos * 1000000)
+				if acct.Amount < threshold {
+					alerts = append(alerts, e.fire(i, addr, now, fmt.Sprintf("balance %f Algos is below %g Algos", float64(acct.Amount)/1000000, r.Algos))...)
+				}
+			case Rekeyed:
+				if seen && acct.AuthAddr != prev.AuthAddr {
+					authAddr := acct.AuthAddr
+					if authAddr == "" {
+						authAddr = "itself"
+					}
+					alerts = append(alerts, e.fire(i, addr, now, fmt.Sprintf("rekeyed to %s", authAddr))...)
+				}
+			case Offline:
+				if seen && prev.Status == "Online" && acct.Status != "Online" {
+					alerts = append(alerts, e.fire(i, addr, now, fmt.Sprintf("went %s", acct.Status))...)
+				}
+			case AssetChanged:
+				before, after := prevBalances[r.Asset], balances[addr][r.Asset]
+				if seen && before != after {
+					alerts = append(alerts, e.fire(i, addr, now, fmt.Sprintf("asset %d balance changed from %d to %d", r.Asset, before, after))...)
+				}
+			}
+		}
+		e.previous[addr] = acct
+		e.balances[addr] = balances[addr]
+	}
+	return alerts
+}
+
+// ObservePayments evaluates the payment rules for the payments in a batch of
+// blocks and returns the new alerts. Rounds which were already evaluated, and
+// the first batch which holds blocks from before the UI started, are skipped.
+func (e *Engine) ObservePayments(payments []Payment, latestRound uint64) []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	start := e.round
+	if latestRound > e.round {
+		e.round = latestRound
+	}
+	if start == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	var alerts []Alert
+	for _, p := range payments {
+		if p.Round <= start {
+			continue
+		}
+		for i, r := range e.rules {
+			if r.Type == OutgoingPayment && r.applies(p.Account) {
+				alerts = append(alerts, e.fire(i, p.Account, now, fmt.Sprintf("sent %s to %s in round %d", p.Amount, p.Receiver, p.Round))...)
+			}
+		}
+	}
+	return alerts
+}
+
+// Recent returns the latest alerts, newest first.
+func (e *Engine) Recent() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]Alert{}, e.recent...)
+}
+
+// HookErr returns the last error from the command or webhook.
+func (e *Engine) HookErr() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.hookErr
+}
@@ -0,0 +1,159 @@
+package alerts
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/config"
+)
+
+const (
+	testAddr1 = "737777777777777777777777777777777777777777777777777UFEJ2CI"
+	testAddr2 = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"
+)
+
+func decode(t *testing.T, addr string) types.Address {
+	t.Helper()
+	decoded, err := types.DecodeAddress(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		rule config.AlertRule
+		want string
+	}{
+		{name: "valid", rule: config.AlertRule{Type: BalanceBelow, Account: testAddr1, Algos: 10}},
+		{name: "unknown type", rule: config.AlertRule{Type: "balance-above"}, want: "unknown type"},
+		{name: "asset without id", rule: config.AlertRule{Type: AssetChanged}, want: "requires an asset"},
+		{name: "bad account", rule: config.AlertRule{Type: Offline, Account: "alice"}, want: "invalid account"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := New(config.Alerts{Rules: []config.AlertRule{tt.rule}})
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("New() error = %v", err)
+				}
+				if e.cooldown != defaultCooldown {
+					t.Errorf("cooldown = %s, want the default %s", e.cooldown, defaultCooldown)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("New() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCooldown(t *testing.T) {
+	e, err := New(config.Alerts{
+		Cooldown: time.Minute,
+		Rules: []config.AlertRule{
+			{Type: BalanceBelow, Algos: 10},
+			{Type: Offline},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr1, addr2 := decode(t, testAddr1), decode(t, testAddr2)
+	start := time.Now()
+
+	tests := []struct {
+		name  string
+		rule  int
+		addr  types.Address
+		after time.Duration
+		fired bool
+	}{
+		{name: "first alert", rule: 0, addr: addr1, fired: true},
+		{name: "cooling down", rule: 0, addr: addr1, after: 59 * time.Second},
+		{name: "other account", rule: 0, addr: addr2, after: 59 * time.Second, fired: true},
+		{name: "other rule", rule: 1, addr: addr1, after: 59 * time.Second, fired: true},
+		{name: "cooldown over", rule: 0, addr: addr1, after: time.Minute, fired: true},
+		{name: "cooling down again", rule: 0, addr: addr1, after: 90 * time.Second},
+	}
+	for _, tt := range tests {
+		alerts := e.fire(tt.rule, tt.addr, start.Add(tt.after), tt.name)
+		if fired := len(alerts) > 0; fired != tt.fired {
+			t.Errorf("%s: fired = %v, want %v", tt.name, fired, tt.fired)
+		}
+	}
+	if recent := e.Recent(); len(recent) != 4 || recent[0].Message != "cooldown over" {
+		t.Errorf("Recent() = %v, want the 4 alerts newest first", recent)
+	}
+}
+
+func TestObserveAccounts(t *testing.T) {
+	e, err := New(config.Alerts{Rules: []config.AlertRule{
+		{Type: BalanceBelow, Account: testAddr1, Algos: 1},
+		{Type: Rekeyed},
+		{Type: Offline},
+		{Type: AssetChanged, Asset: 31566704},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr1, addr2 := decode(t, testAddr1), decode(t, testAddr2)
+
+	info := map[types.Address]models.Account{
+		addr1: {Amount: 500000, Status: "Online"},
+		addr2: {Amount: 500000, Status: "Online"},
+	}
+	balances := map[types.Address]map[uint64]uint64{addr1: {31566704: 5}}
+	alerts := e.ObserveAccounts(info, balances)
+	if len(alerts) != 1 || alerts[0].Rule != BalanceBelow || alerts[0].Account != testAddr1 {
+		t.Fatalf("first update alerts = %v, want only the balance of %s", alerts, testAddr1)
+	}
+
+	info = map[types.Address]models.Account{
+		addr1: {Amount: 500000, Status: "Online"},
+		addr2: {Amount: 500000, Status: "Offline", AuthAddr: testAddr1},
+	}
+	balances = map[types.Address]map[uint64]uint64{addr1: {31566704: 7}}
+	rules := map[string]string{}
+	for _, a := range e.ObserveAccounts(info, balances) {
+		rules[a.Rule] = a.Account
+	}
+	want := map[string]string{Rekeyed: testAddr2, Offline: testAddr2, AssetChanged: testAddr1}
+	if len(rules) != len(want) {
+		t.Errorf("second update alerts = %v, want %v", rules, want)
+	}
+	for rule, account := range want {
+		if rules[rule] != account {
+			t.Errorf("%s alert for %q, want %s", rule, rules[rule], account)
+		}
+	}
+}
+
+func TestObservePayments(t *testing.T) {
+	e, err := New(config.Alerts{Rules: []config.AlertRule{{Type: OutgoingPayment, Account: testAddr1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr1, addr2 := decode(t, testAddr1), decode(t, testAddr2)
+
+	// The first batch holds blocks from before the UI started.
+	if alerts := e.ObservePayments([]Payment{{Account: addr1, Round: 10}}, 10); len(alerts) != 0 {
+		t.Errorf("first batch alerts = %v, want none", alerts)
+	}
+	payments := []Payment{
+		{Account: addr1, Round: 10, Amount: "1 Algos"},
+		{Account: addr2, Round: 11, Amount: "2 Algos"},
+		{Account: addr1, Round: 11, Amount: "3 Algos", Receiver: testAddr2},
+	}
+	alerts := e.ObservePayments(payments, 11)
+	if len(alerts) != 1 || !strings.Contains(alerts[0].Message, "sent 3 Algos") {
+		t.Errorf("alerts = %v, want the new payment from %s", alerts, testAddr1)
+	}
+}
@@ -0,0 +1,72 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// hookTimeout limits how long a command or webhook may take.
+const hookTimeout = 10 * time.Second
+
+// NotifyCmd sends the alerts to the configured command and webhook. It
+// returns nil when there is nothing to do.
+func (e *Engine) NotifyCmd(alerts []Alert) tea.Cmd {
+	if len(alerts) == 0 || (e.command == "" && e.webhook == "") {
+		return nil
+	}
+	return func() tea.Msg {
+		var err error
+		for _, alert := range alerts {
+			if hookErr := e.notify(alert); hookErr != nil {
+				err = hookErr
+			}
+		}
+		e.mu.Lock()
+		e.hookErr = err
+		e.mu.Unlock()
+		return nil
+	}
+}
+
+// notify sends a single alert.
+func (e *Engine) notify(alert Alert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	if e.command != "" {
+		cmd := exec.CommandContext(ctx, e.command)
+		cmd.Stdin = bytes.NewReader(data)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("alert command: %w: %s", err, bytes.TrimSpace(output))
+		}
+	}
+
+	if e.webhook != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.webhook, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("alert webhook: %w", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("alert webhook: %s", resp.Status)
+		}
+	}
+	return nil
+}
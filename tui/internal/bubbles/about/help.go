@@ -14,8 +14,10 @@ Easy access to **important** tools and node information.
 Continuous status is available for:
 * Network information.
 * Protocol upgrade status.
-* Catchup sync time.
-* Fast catchup progress.
+* Warnings when this node does not support an upcoming protocol.
+* Catchup sync time, with an estimated time to sync.
+* Recent round times, with min/avg/p95.
+* Fast catchup progress for each phase, with rates and ETAs.
 
 # Explorer
 
@@ -49,21 +51,81 @@ Shortcuts for handy utilities.
 
 View all of your accounts along with recent transactions.
 
+Each account charts its recent Algo balance changes. Press **enter** on an
+account for its full details: online status, rewards, minimum balance, rekey
+address, asset holdings with their change this session, applications and boxes.
+
+Transactions involving watched accounts, including inner transactions, are
+collected from the blocks tailed by the explorer. The list shows the latest few,
+the details show the full feed. Select a transaction with **↑/↓** and press
+**enter** to open it in the explorer.
+
+Online accounts show their participation key validity, key dilution and when
+the keys expire. Keys expiring within a week are highlighted, and accounts
+which are online without a matching key on this node are flagged.
+
+Alert rules from the settings file are evaluated on every account update and
+tailed block. The latest alerts are listed above the watch list, and the
+number of alerts is shown in the status bar.
+
+Accounts from the address book (**--address-book**) are shown by their label
+here, as transaction senders in the explorer and as block proposers.
+
+Manage the watch list from the accounts tab:
+* **n** add an account address.
+* **x** remove the selected account.
+* **K** / **J** move the selected account up or down.
+
+# Participation
+
+Participation keys installed on the node, with their validity range, effective
+round, last vote and proposal, and the blocks proposed this session. Managing
+keys requires the algod admin token, and over SSH the operator role. Each
+action is confirmed first, on mainnet by typing the genesis ID.
+* **i** install a participation key file, read on this machine and uploaded to the node.
+* **g** generate a key with "address first-round last-round [dilution]".
+* **x** delete the selected key.
+
 # Configuration
 
-Full node configuration details.
-` + "```json" + `
-{
-    "Version": 16,
-    "AccountsRebuildSynchronousMode": 1,
-    "AnnounceParticipationKey": true,
-    "Archival": false,
-    "BaseLoggerDebugLevel": 4,
-    "BroadcastConnectionsLimit": -1,
-    "CadaverSizeTarget": 1073741824,
-    ...
-}
-` + "```" + `
+The node config.json from the data directory, merged with the algod defaults
+for its config Version. Each setting shows its effective value and default,
+settings changed from the default are marked with *****, and settings which
+are unknown for the config version with **?**. Without a data directory the
+settings and genesis file are requested from the algod API, the header shows
+where they were read from.
+* **/** filter settings by name.
+* **o** only show overridden settings.
+* **e** edit the selected setting, bool settings are toggled. Editing requires
+  starting the UI with **--allow-config-write**, and over SSH the operator
+  role.
+* **d** list the other files in the data directory: genesis.json,
+  logging.config, phonebook.json, consensus.json and the kmd config. Press
+  **enter** to view a file, the genesis file starts with a summary of the
+  network and its largest allocations.
+
+Each edit is confirmed first, on mainnet by typing the genesis ID, and backs
+up the previous config.json with a timestamp before writing the new file. Algod reads config.json on startup, so restart it to apply the
+changes.
+
+# Logs
+
+Tails node.log from the data directory, following the log when algod archives
+it to node.archive.log. Lines are colored by level.
+* **/** filter with words, or field=value to match a JSON field such as
+  **Context=Agreement** or **function=catchup**, ignoring case.
+* **l** cycle the minimum level: all, info, warning, error.
+* **p** pause and resume, new lines are counted while paused.
+* **↑/↓** scroll back, **G** follow the newest lines again.
+* **v** switch to the audit log, the read only record of the catchup, config,
+  participation key and shutdown actions taken through the UI, with who took
+  them and the algod response.
+
+# Sessions
+
+The SSH sessions connected to the UI: the user, role, key fingerprint, remote
+address, when it connected and how long since its last input. The list is
+only shown to operators.
 
 # Help
 
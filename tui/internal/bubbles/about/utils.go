@@ -1,13 +1,22 @@
 package about
 
-// GetUtilsContent returns the utilities' markdown string.
-func GetUtilsContent() string {
-	return `## From this tab use the following keys to launch utility functions.
-
-* **F** Immediately begin a fast catchup, status is displayed.
+// GetUtilsContent returns the utilities' markdown string. The fast catchup
+// keys are only listed for operators.
+func GetUtilsContent(operator bool) string {
+	catchup := `* **F** Begin a fast catchup to the latest catchpoint, status is displayed.
 
 * **A** Abort an ongoing fast catchup.
 
+Both ask for a confirmation showing the network and catchpoint, on mainnet the
+genesis ID must be typed out.
+`
+	if !operator {
+		catchup = `* Fast catchup requires the operator role, your SSH key has the viewer role.
+`
+	}
+	return `## From this tab use the following keys to launch utility functions.
+
+` + catchup + `
 * **S** Send a payment transaction.
 
 * **D** Delete block from the blockchain.
@@ -2,52 +2,58 @@ package accounts
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 
+	"github.com/algorand/node-ui/config"
 	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/alerts"
+	"github.com/algorand/node-ui/tui/internal/bubbles/explorer"
+	"github.com/algorand/node-ui/tui/internal/constants"
+	"github.com/algorand/node-ui/tui/internal/layout"
 	"github.com/algorand/node-ui/tui/internal/style"
 )
 
-var (
-	titleStyle = func() lipgloss.Style {
-		b := lipgloss.RoundedBorder()
-		b.Right = "├"
-		return lipgloss.NewStyle().BorderStyle(b).Padding(0, 1)
-	}()
-
-	infoStyle = func() lipgloss.Style {
-		b := lipgloss.RoundedBorder()
-		b.Left = "┤"
-		return titleStyle.Copy().BorderStyle(b)
-	}()
-)
-
 type balance struct {
 	MicroAlgos uint64
 	TimeStamp  time.Time
 }
 
 type account struct {
-	Balances       map[uint64]uint64
+	Balances map[uint64]uint64
+	// Initial has the first balances seen this session.
+	Initial map[uint64]uint64
+	// BalanceHistory has the Algo balance changes, oldest first.
 	BalanceHistory []balance
+	// Transactions is the feed from tailed blocks, newest first.
+	Transactions []feedItem
+	Info         models.Account
 }
 
 func makeAccount() *account {
 	return &account{
 		Balances: make(map[uint64]uint64),
-		BalanceHistory: []balance{
-			{0, time.Now()},
-			{0, time.Now()},
-			{0, time.Now()},
-		}}
+	}
+}
+
+// copyAccounts replaces the accounts with copies before they are changed,
+// the initial model is shared by SSH sessions.
+func (m *Model) copyAccounts() {
+	accounts := make(map[types.Address]*account, len(m.Accounts))
+	for addr, acct := range m.Accounts {
+		copied := *acct
+		accounts[addr] = &copied
+	}
+	m.Accounts = accounts
 }
 
 // Model representing the account bubble.
@@ -60,23 +66,132 @@ type Model struct {
 	viewport     viewport.Model
 	heightMargin int
 
+	// detail is set while viewing the selected account, listOffset restores
+	// the list scroll position when going back.
+	detail     bool
+	listOffset int
+	// feedSelected is the selected transaction in the detail view, and
+	// feedOffset is the first line of the feed in the detail content.
+	feedSelected int
+	feedOffset   int
+
+	// watch list editing state
+	selected int
+	adding   bool
+	input    textinput.Model
+	inputErr error
+	saveErr  error
+	// offsets are the first line of each account in the viewport content.
+	offsets []int
+	// labels are set from the watch list, book has read-only labels from the
+	// address book.
+	labels map[types.Address]string
+	book   *config.AddressBook
+
+	// config is optional, when set watch list changes are saved to it.
+	// Changes made by viewers are kept for their session only.
+	config     *config.Config
+	viewer     bool
+	refresh    time.Duration
+	historyLen int
+
+	// assetParams has the names and decimals of held assets.
+	assetParams map[uint64]models.AssetParams
+	// assetsRequested are the assets whose parameters were requested. An
+	// asset which could not be fetched, e.g. because it was destroyed, is
+	// not requested again.
+	assetsRequested map[uint64]bool
+	// lastRound is the latest block scanned for transactions.
+	lastRound uint64
+
+	// alerts is optional, it evaluates the alert rules.
+	alerts *alerts.Engine
+
+	// participationKeys are the keys installed on this node, round and
+	// blockTime are used to estimate when keys expire.
+	participationKeys []messages.ParticipationKey
+	participationErr  error
+	round             uint64
+	blockTime         time.Duration
+
 	requestor *messages.Requestor
 }
 
-// New creates the accounts Model.
-func New(style *style.Styles, requestor *messages.Requestor, initialHeight int, heightMargin int, accounts []types.Address) Model {
+// defaultRefresh is how often accounts are polled when not configured.
+const defaultRefresh = 5 * time.Second
+
+// accountsTickMsg triggers the next account status poll.
+type accountsTickMsg struct{}
+
+// watchListSavedMsg reports the result of saving the watch list.
+type watchListSavedMsg struct {
+	err error
+}
+
+// New creates the accounts Model. The config is optional, it provides
+// account labels and the refresh rate, and watch list edits are saved to it.
+// The address book and alert engine are also optional.
+func New(style *style.Styles, requestor *messages.Requestor, initialHeight int, heightMargin int, accounts []types.Address, cfg *config.Config, book *config.AddressBook, engine *alerts.Engine) Model {
+	input := textinput.New()
+	input.Prompt = "Address: "
+	input.Placeholder = "account address to watch, optionally followed by a label"
+	input.CharLimit = 128
+
 	rval := Model{
 		Accounts:     make(map[types.Address]*account),
 		style:        style,
 		viewport:     viewport.New(0, 0),
 		heightMargin: heightMargin,
+		input:        input,
+		labels:       make(map[types.Address]string),
+		config:       cfg,
+		refresh:      defaultRefresh,
+		historyLen:   defaultHistory,
+		assetParams:  make(map[uint64]models.AssetParams),
 		requestor:    requestor,
+		book:         book,
+		alerts:       engine,
+	}
+	if cfg != nil {
+		for addr, label := range cfg.Labels() {
+			if decoded, err := types.DecodeAddress(addr); err == nil {
+				rval.labels[decoded] = label
+			}
+		}
+		if cfg.Refresh.Accounts > 0 {
+			rval.refresh = cfg.Refresh.Accounts
+		}
+		if cfg.BalanceHistory > 0 {
+			rval.historyLen = cfg.BalanceHistory
+		}
 	}
 	rval.setSize(80, initialHeight)
 	rval.SetAccounts(accounts)
 	return rval
 }
 
+// labelFor returns the watch list or address book label of an account, or
+// an empty string.
+func (m Model) labelFor(addr types.Address) string {
+	if label, ok := m.labels[addr]; ok {
+		return label
+	}
+	label, _ := m.book.Label(addr)
+	return label
+}
+
+// displayName returns the label of an address when there is one.
+func (m Model) displayName(address string) string {
+	addr, err := types.DecodeAddress(address)
+	if err != nil {
+		return address
+	}
+	if label := m.labelFor(addr); label != "" {
+		return label
+	}
+	return address
+}
+
 // SetAccounts updates the accounts to monitor.
 func (m *Model) SetAccounts(accounts []types.Address) {
 	updated := make(map[types.Address]*account)
@@ -89,19 +204,289 @@ func (m *Model) SetAccounts(accounts []types.Address) {
 	}
 	m.Accounts = updated
 	m.accounts = accounts
+	if m.selected >= len(accounts) {
+		m.selected = layout.Max(0, len(accounts)-1)
+	}
+}
+
+// CapturesInput is true while an address is being typed, so that global
+// key bindings are not triggered.
+func (m Model) CapturesInput() bool {
+	return m.adding
 }
 
 func (m *Model) setSize(width, height int) {
-	footerHeight := lipgloss.Height(m.footerView())
+	footerHeight := lipgloss.Height(m.promptView()) + lipgloss.Height(m.footerView())
 	m.viewport.Width = width
 	m.viewport.Height = height - m.heightMargin - footerHeight
 }
 
+// WithOperator returns the Model for a session, watch list changes are only
+// saved for operators.
+func (m Model) WithOperator(operator bool) tea.Model {
+	m.viewer = !operator
+	return m
+}
+
 // Init is part of the tea.Model interface.
 func (m Model) Init() tea.Cmd {
 	return m.requestor.GetAccountStatusCmd(m.accounts)
 }
 
+// watchListUpdate is a single change to the saved watch list.
+type watchListUpdate func([]config.Account) []config.Account
+
+// watchIndex finds an account in the saved watch list, where it may be
+// listed by its address book label. It returns -1 when it is not saved.
+func watchIndex(list []config.Account, addr types.Address, book *config.AddressBook) int {
+	for i, acct := range list {
+		if acct.Address == addr.String() {
+			return i
+		}
+		if labeled, ok := book.Lookup(acct.Address); ok && labeled == addr {
+			return i
+		}
+	}
+	return -1
+}
+
+// addAccount validates and appends an address to the watch list. The
+// address may be followed by a label. It returns the change to save.
+func (m *Model) addAccount(input string) (watchListUpdate, error) {
+	address, label, _ := strings.Cut(strings.TrimSpace(input), " ")
+	addr, err := types.DecodeAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if _, ok := m.Accounts[addr]; ok {
+		return nil, fmt.Errorf("already watching %s", addr)
+	}
+
+	if label = strings.TrimSpace(label); label != "" {
+		// Copy, the initial model is shared by SSH sessions.
+		labels := make(map[types.Address]string, len(m.labels)+1)
+		for a, l := range m.labels {
+			labels[a] = l
+		}
+		labels[addr] = label
+		m.labels = labels
+	}
+	m.SetAccounts(append(append([]types.Address{}, m.accounts...), addr))
+	m.selected = len(m.accounts) - 1
+
+	entry, book := config.Account{Address: addr.String(), Label: label}, m.book
+	return func(list []config.Account) []config.Account {
+		if watchIndex(list, addr, book) >= 0 {
+			return list
+		}
+		return append(list, entry)
+	}, nil
+}
+
+// saveCmd applies a watch list change to the config file.
+func (m Model) saveCmd(update watchListUpdate) tea.Cmd {
+	if update == nil || m.viewer || m.config == nil || m.config.Path() == "" {
+		return nil
+	}
+	cfg := m.config
+	return func() tea.Msg {
+		return watchListSavedMsg{err: cfg.UpdateWatchList(update)}
+	}
+}
+
+// removeSelected removes the selected account from the watch list and
+// returns the change to save.
+func (m *Model) removeSelected() watchListUpdate {
+	if len(m.accounts) == 0 {
+		return nil
+	}
+	addr, book := m.accounts[m.selected], m.book
+	updated := append([]types.Address{}, m.accounts[:m.selected]...)
+	m.SetAccounts(append(updated, m.accounts[m.selected+1:]...))
+
+	return func(list []config.Account) []config.Account {
+		if i := watchIndex(list, addr, book); i >= 0 {
+			return append(list[:i], list[i+1:]...)
+		}
+		return list
+	}
+}
+
+// moveSelected moves the selected account up or down the watch list and
+// returns the change to save. Accounts which are not saved, such as those
+// from the command line, keep their place.
+func (m *Model) moveSelected(delta int) watchListUpdate {
+	target := m.selected + delta
+	if target < 0 || target >= len(m.accounts) {
+		return nil
+	}
+	a, b, book := m.accounts[m.selected], m.accounts[target], m.book
+	updated := append([]types.Address{}, m.accounts...)
+	updated[m.selected], updated[target] = b, a
+	m.SetAccounts(updated)
+	m.selected = target
+
+	return func(list []config.Account) []config.Account {
+		i, j := watchIndex(list, a, book), watchIndex(list, b, book)
+		if i >= 0 && j >= 0 {
+			list[i], list[j] = list[j], list[i]
+		}
+		return list
+	}
+}
+
+// updateInput handles keys while an address is being typed.
+func (m Model) updateInput(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.adding = false
+		m.inputErr = nil
+		m.input.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		update, err := m.addAccount(m.input.Value())
+		if err != nil {
+			m.inputErr = err
+			return m, nil
+		}
+		m.adding = false
+		m.inputErr = nil
+		m.input.Blur()
+		m.refreshContent()
+		m.scrollToSelected()
+		return m, m.saveCmd(update)
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// updateKeys handles the watch list key bindings.
+func (m Model) updateKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.adding {
+		return m.updateInput(msg)
+	}
+
+	var cmd tea.Cmd
+	if m.detail {
+		return m.updateDetailKeys(msg)
+	}
+
+	switch {
+	case key.Matches(msg, constants.Keys.Forward):
+		if len(m.accounts) == 0 {
+			return m, nil
+		}
+		m.detail = true
+		m.feedSelected = 0
+		m.listOffset = m.viewport.YOffset
+		m.refreshContent()
+		m.viewport.GotoTop()
+		return m, nil
+	case key.Matches(msg, constants.Keys.AddAccount):
+		m.adding = true
+		m.inputErr = nil
+		m.input.Reset()
+		return m, m.input.Focus()
+	case key.Matches(msg, constants.Keys.RemoveAccount):
+		cmd = m.saveCmd(m.removeSelected())
+	case key.Matches(msg, constants.Keys.MoveUp):
+		cmd = m.saveCmd(m.moveSelected(-1))
+	case key.Matches(msg, constants.Keys.MoveDown):
+		cmd = m.saveCmd(m.moveSelected(1))
+	case key.Matches(msg, constants.Keys.Up):
+		if m.selected > 0 {
+			m.selected--
+		}
+	case key.Matches(msg, constants.Keys.Down):
+		if m.selected < len(m.accounts)-1 {
+			m.selected++
+		}
+	default:
+		// Let the viewport handle paging.
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	m.refreshContent()
+	m.scrollToSelected()
+	return m, cmd
+}
+
+// updateDetailKeys handles keys in the detail view, up and down select a
+// transaction in the feed and enter opens it in the explorer.
+func (m Model) updateDetailKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var feed []feedItem
+	if acct, ok := m.Accounts[m.accounts[m.selected]]; ok {
+		feed = acct.Transactions
+	}
+
+	var cmd tea.Cmd
+	switch {
+	case key.Matches(msg, constants.Keys.Back):
+		m.detail = false
+		m.refreshContent()
+		m.viewport.SetYOffset(m.listOffset)
+		return m, nil
+	case key.Matches(msg, constants.Keys.Forward):
+		if m.feedSelected < len(feed) {
+			item := feed[m.feedSelected]
+			return m, func() tea.Msg {
+				return explorer.ShowTransactionMsg{Round: item.Round, Intra: item.Intra}
+			}
+		}
+		return m, nil
+	case key.Matches(msg, constants.Keys.Up) && m.feedSelected > 0:
+		m.feedSelected--
+	case key.Matches(msg, constants.Keys.Down) && m.feedSelected < len(feed)-1:
+		m.feedSelected++
+	default:
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	m.refreshContent()
+	line := m.feedOffset + m.feedSelected
+	switch {
+	case line < m.viewport.YOffset:
+		m.viewport.SetYOffset(line)
+	case line >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(line - m.viewport.Height + 1)
+	}
+	return m, nil
+}
+
+// refreshContent renders the list or the detail view into the viewport.
+func (m *Model) refreshContent() {
+	if m.detail && m.selected < len(m.accounts) {
+		var content string
+		content, m.feedOffset = m.detailString(m.accounts[m.selected])
+		m.viewport.SetContent(content)
+		return
+	}
+	m.viewport.SetContent(m.buildString())
+}
+
+// scrollToSelected keeps the selected account visible in the viewport.
+func (m *Model) scrollToSelected() {
+	if m.selected >= len(m.offsets) {
+		return
+	}
+	top := m.offsets[m.selected]
+	bottom := m.viewport.TotalLineCount()
+	if m.selected+1 < len(m.offsets) {
+		bottom = m.offsets[m.selected+1]
+	}
+
+	switch {
+	case top < m.viewport.YOffset:
+		m.viewport.SetYOffset(top)
+	case bottom > m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(bottom - m.viewport.Height)
+	}
+}
+
 // Update is part of the tea.Model interface.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var (
@@ -110,41 +495,107 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	)
 
 	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.updateKeys(msg)
+
 	case tea.WindowSizeMsg:
 		m.setSize(msg.Width, msg.Height)
 
+	case watchListSavedMsg:
+		m.saveErr = msg.err
+		return m, nil
+
+	case messages.BlockTimeMsg:
+		m.round = msg.Round
+		m.blockTime = msg.Average
+		if !m.adding {
+			m.refreshContent()
+		}
+
+	case accountsTickMsg:
+		// Poll the current watch list, it may have changed since the last poll.
+		return m, m.requestor.GetAccountStatusCmd(m.accounts)
+
 	case messages.AccountStatusMsg:
 		cmds = append(cmds,
-			tea.Tick(5*time.Second, func(time.Time) tea.Msg {
-				return m.requestor.GetAccountStatusCmd(m.accounts)()
+			tea.Tick(m.refresh, func(time.Time) tea.Msg {
+				return accountsTickMsg{}
 			}),
 		)
 
-		for msgAddress, msgBalances := range msg.Balances {
-			acct := m.Accounts[msgAddress]
+		if msg.Err == nil {
+			m.participationKeys = msg.ParticipationKeys
+			m.participationErr = msg.ParticipationErr
+		}
+		m.copyAccounts()
+		for addr, info := range msg.Info {
+			if acct, ok := m.Accounts[addr]; ok {
+				acct.Info = info
+			}
+		}
 
-			// Don't update if the balance didn't change
-			if msgBalances[0] == acct.Balances[0] {
-				break
+		unknownAssets := make(map[uint64]bool)
+		for msgAddress, msgBalances := range msg.Balances {
+			acct, ok := m.Accounts[msgAddress]
+			// The account was removed from the watch list.
+			if !ok {
+				continue
 			}
 
-			newBalance := balance{
+			acct.observeBalances(msgBalances, balance{
 				MicroAlgos: msgBalances[0],
 				TimeStamp:  time.Now(),
-			}
+			}, m.historyLen)
 
-			// Prepend the balance
-			tmpList := append([]balance{newBalance}, acct.BalanceHistory...)
-			if len(tmpList) > 3 {
-				tmpList = tmpList[:3]
+			for id := range msgBalances {
+				if !m.assetsRequested[id] && id != 0 {
+					unknownAssets[id] = true
+				}
+			}
+		}
+		if len(unknownAssets) > 0 {
+			// Copy, the initial model is shared by SSH sessions.
+			requested := make(map[uint64]bool, len(m.assetsRequested)+len(unknownAssets))
+			for id := range m.assetsRequested {
+				requested[id] = true
 			}
-			acct.BalanceHistory = tmpList
-			acct.Balances = msgBalances
+			ids := make([]uint64, 0, len(unknownAssets))
+			for id := range unknownAssets {
+				ids = append(ids, id)
+				requested[id] = true
+			}
+			m.assetsRequested = requested
+			cmds = append(cmds, m.requestor.GetAssetParamsCmd(ids))
+		}
 
-			m.Accounts[msgAddress] = acct
+		if m.alerts != nil && msg.Err == nil {
+			cmds = append(cmds, m.alerts.NotifyCmd(m.alerts.ObserveAccounts(msg.Info, msg.Balances)))
+		}
+
+		m.refreshContent()
+
+	case explorer.BlocksMsg:
+		payments := m.observeBlocks(msg.Blocks)
+		if m.alerts != nil {
+			cmds = append(cmds, m.alerts.NotifyCmd(m.alerts.ObservePayments(payments, m.lastRound)))
+		}
+		if !m.adding {
+			m.refreshContent()
 		}
 
-		m.viewport.SetContent(m.buildString())
+	case messages.AssetParamsMsg:
+		// Copy, the initial model is shared by SSH sessions.
+		params := make(map[uint64]models.AssetParams, len(m.assetParams)+len(msg.Params))
+		for id, p := range m.assetParams {
+			params[id] = p
+		}
+		for id, p := range msg.Params {
+			params[id] = p
+		}
+		m.assetParams = params
+		if !m.adding {
+			m.refreshContent()
+		}
 	}
 
 	m.viewport, cmd = m.viewport.Update(msg)
@@ -157,48 +608,106 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m Model) View() string {
 
 	builder := strings.Builder{}
-	builder.WriteString(fmt.Sprintf("%s\n%s", m.viewport.View(), m.footerView()))
+	builder.WriteString(fmt.Sprintf("%s\n%s\n%s", m.viewport.View(), m.promptView(), m.footerView()))
 	return builder.String()
 }
 
-func (m Model) buildString() string {
+func (m *Model) buildString() string {
 	builder := strings.Builder{}
-
-	keys := make([]string, 0, len(m.Accounts))
-	for k := range m.Accounts {
-		keys = append(keys, k.String())
-	}
-	sort.Strings(keys)
-
-	for _, account := range keys {
-		accountType, _ := types.DecodeAddress(account)
-		v := m.Accounts[accountType]
-		builder.WriteString(fmt.Sprintf("%s %s\n", m.style.AccountBoldText.Render("Account:"), m.style.AccountYellowText.Render(account)))
+	m.offsets = m.offsets[:0:0]
+	builder.WriteString(m.alertsView())
+
+	for i, addr := range m.accounts {
+		m.offsets = append(m.offsets, strings.Count(builder.String(), "\n"))
+		v := m.Accounts[addr]
+		cursor := " "
+		if i == m.selected {
+			cursor = ">"
+		}
+		var label string
+		if l := m.labelFor(addr); l != "" {
+			label = " " + m.style.AccountBoldText.Render(l)
+		}
+		builder.WriteString(fmt.Sprintf("%s %s %s%s\n",
+			m.style.BottomListItemSelector.Copy().MarginLeft(0).Render(cursor),
+			m.style.AccountBoldText.Render("Account:"),
+			m.style.AccountYellowText.Render(addr.String()),
+			label))
 
 		algoStr := fmt.Sprintf("         %f Algos", float64(v.Balances[0])/1000000.0)
-		builder.WriteString(m.style.AccountBlueText.Render(algoStr) + "\n")
-		for _, a := range v.BalanceHistory {
-			if a.MicroAlgos == 0 {
-				builder.WriteString("\n")
-			} else {
-				pastStr := fmt.Sprintf("         %f Algos @ %s\n", float64(a.MicroAlgos)/1000000, a.TimeStamp.Format("2006-01-02 15:04:05.1234"))
-				builder.WriteString(pastStr)
-			}
+		builder.WriteString(m.style.AccountBlueText.Render(algoStr))
+		if summary := m.statusSummary(v.Info); summary != "" {
+			builder.WriteString("  " + m.style.AccountHelpText.Render(summary))
+		}
+		builder.WriteString("\n")
+		if summary := m.participationSummary(addr, v.Info); summary != "" {
+			builder.WriteString("         " + summary + "\n")
+		}
+		if summary := m.historySummary(v); summary != "" {
+			builder.WriteString("         " + summary + "\n")
 		}
+		if len(v.Transactions) > 0 {
+			builder.WriteString(m.feedView(v.Transactions[:min(len(v.Transactions), listFeed)], -1))
+		}
+		builder.WriteString("\n")
 
 	}
 
 	return m.style.Account.Render(builder.String())
 }
-func (m Model) footerView() string {
-	info := infoStyle.Render(fmt.Sprintf("%3.f%%", m.viewport.ScrollPercent()*100))
-	line := strings.Repeat("─", max(0, m.viewport.Width-lipgloss.Width(info)))
-	return lipgloss.JoinHorizontal(lipgloss.Center, line, info)
+
+// maxListAlerts is how many alerts are shown above the watch list.
+const maxListAlerts = 5
+
+// alertsView renders the latest alerts above the watch list.
+func (m Model) alertsView() string {
+	if m.alerts == nil {
+		return ""
+	}
+	recent := m.alerts.Recent()
+	hookErr := m.alerts.HookErr()
+	if len(recent) == 0 && hookErr == nil {
+		return ""
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(m.style.AccountBoldText.Render(fmt.Sprintf("Alerts (%d)", len(recent))))
+	builder.WriteString("\n")
+	for _, alert := range recent[:min(len(recent), maxListAlerts)] {
+		line := fmt.Sprintf("%s %s: %s", alert.Time.Format("15:04:05"), m.displayName(alert.Account), alert.Message)
+		builder.WriteString("  " + m.style.StatusWarningText.Render(line) + "\n")
+	}
+	if hookErr != nil {
+		builder.WriteString("  " + m.style.StatusCriticalText.Render(fmt.Sprintf("Failed to send alerts: %s", hookErr)) + "\n")
+	}
+	builder.WriteString("\n")
+	return builder.String()
 }
 
-func max(a, b int) int {
-	if a > b {
-		return a
+// promptView shows the address input while adding an account, otherwise a
+// hint with the watch list key bindings.
+func (m Model) promptView() string {
+	switch {
+	case m.adding && m.inputErr != nil:
+		return fmt.Sprintf("%s  %s", m.input.View(), m.style.StatusCriticalText.Render(m.inputErr.Error()))
+	case m.adding:
+		return m.input.View()
+	case m.detail:
+		k := constants.Keys
+		return m.style.AccountHelpText.Render(fmt.Sprintf("%s: back • %s: select transaction • %s: open in explorer",
+			k.Back.Help().Key, k.Generic.Help().Key, k.Forward.Help().Key))
+	case m.saveErr != nil:
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Failed to save watch list: %s", m.saveErr))
+	default:
+		k := constants.Keys
+		return m.style.AccountHelpText.Render(fmt.Sprintf("%s: details • %s: %s • %s: %s • %s/%s: move",
+			k.Forward.Help().Key,
+			k.AddAccount.Help().Key, k.AddAccount.Help().Desc,
+			k.RemoveAccount.Help().Key, k.RemoveAccount.Help().Desc,
+			k.MoveUp.Help().Key, k.MoveDown.Help().Key))
 	}
-	return b
+}
+
+func (m Model) footerView() string {
+	return layout.Footer(m.viewport.ScrollPercent(), m.viewport.Width)
 }
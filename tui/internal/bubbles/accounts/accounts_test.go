@@ -0,0 +1,175 @@
+package accounts
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/config"
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+var (
+	addr1 = types.Address{1}
+	addr2 = types.Address{2}
+	addr3 = types.Address{3}
+)
+
+// newTestModel watches the addresses, which are also saved in a config file.
+func newTestModel(t *testing.T, addresses ...types.Address) (Model, *config.Config) {
+	t.Helper()
+	cfg, err := config.Load(filepath.Join(t.TempDir(), "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cfg.UpdateWatchList(func([]config.Account) []config.Account {
+		var list []config.Account
+		for _, addr := range addresses {
+			list = append(list, config.Account{Address: addr.String()})
+		}
+		return list
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(style.DefaultStyles(), nil, 40, 10, addresses, cfg, nil, nil), cfg
+}
+
+func runeKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// save runs the save command of an edit.
+func save(t *testing.T, cmd tea.Cmd) {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("no save command")
+	}
+	msg, ok := cmd().(watchListSavedMsg)
+	if !ok || msg.err != nil {
+		t.Fatalf("save = %#v, want a successful watchListSavedMsg", msg)
+	}
+}
+
+func watchList(cfg *config.Config) []string {
+	var list []string
+	for _, acct := range cfg.WatchList {
+		list = append(list, acct.Address)
+	}
+	return list
+}
+
+func TestWatchIndex(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "book.csv")
+	if err := os.WriteFile(path, []byte("relay,"+addr2.String()+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	book, err := config.LoadAddressBook(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := []config.Account{{Address: addr1.String()}, {Address: "relay"}}
+	tests := []struct {
+		addr types.Address
+		want int
+	}{
+		{addr: addr1, want: 0},
+		{addr: addr2, want: 1},
+		{addr: addr3, want: -1},
+	}
+	for _, tt := range tests {
+		if got := watchIndex(list, tt.addr, book); got != tt.want {
+			t.Errorf("watchIndex(%s) = %d, want %d", tt.addr, got, tt.want)
+		}
+	}
+	if got := watchIndex(list, addr2, nil); got != -1 {
+		t.Errorf("watchIndex without an address book = %d, want -1", got)
+	}
+}
+
+func TestAddAccount(t *testing.T) {
+	m, cfg := newTestModel(t, addr1)
+
+	if _, err := m.addAccount("not an address"); err == nil {
+		t.Error("added an invalid address")
+	}
+	if _, err := m.addAccount(addr1.String()); err == nil {
+		t.Error("added an account which is already watched")
+	}
+
+	update, err := m.addAccount(addr2.String() + " my relay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.accounts, []types.Address{addr1, addr2}) || m.selected != 1 {
+		t.Errorf("accounts = %v selected %d, want the new account selected", m.accounts, m.selected)
+	}
+	if m.labels[addr2] != "my relay" {
+		t.Errorf("label = %q, want my relay", m.labels[addr2])
+	}
+	save(t, m.saveCmd(update))
+	want := []config.Account{{Address: addr1.String()}, {Address: addr2.String(), Label: "my relay"}}
+	if !reflect.DeepEqual(cfg.WatchList, want) {
+		t.Errorf("saved watch list = %v, want %v", cfg.WatchList, want)
+	}
+}
+
+func TestRemoveAndMoveSelected(t *testing.T) {
+	m, cfg := newTestModel(t, addr1, addr2, addr3)
+
+	next, cmd := m.updateKeys(runeKey("J"))
+	save(t, cmd)
+	if !reflect.DeepEqual(next.accounts, []types.Address{addr2, addr1, addr3}) || next.selected != 1 {
+		t.Errorf("after moving down accounts = %v selected %d", next.accounts, next.selected)
+	}
+	if want := []string{addr2.String(), addr1.String(), addr3.String()}; !reflect.DeepEqual(watchList(cfg), want) {
+		t.Errorf("saved watch list = %v, want %v", watchList(cfg), want)
+	}
+
+	next, cmd = next.updateKeys(runeKey("x"))
+	save(t, cmd)
+	if !reflect.DeepEqual(next.accounts, []types.Address{addr2, addr3}) {
+		t.Errorf("after removing accounts = %v", next.accounts)
+	}
+	if want := []string{addr2.String(), addr3.String()}; !reflect.DeepEqual(watchList(cfg), want) {
+		t.Errorf("saved watch list = %v, want %v", watchList(cfg), want)
+	}
+
+	// Moving past the end does nothing.
+	next.selected = 1
+	if _, cmd = next.updateKeys(runeKey("J")); cmd != nil {
+		t.Error("moving the last account down returned a save command")
+	}
+
+	// The shared initial model is not changed.
+	if !reflect.DeepEqual(m.accounts, []types.Address{addr1, addr2, addr3}) {
+		t.Errorf("initial model accounts = %v, want them unchanged", m.accounts)
+	}
+}
+
+func TestViewerChangesAreNotSaved(t *testing.T) {
+	initial, cfg := newTestModel(t, addr1, addr2)
+	m := initial.WithOperator(false).(Model)
+
+	m, cmd := m.updateKeys(runeKey("x"))
+	if cmd != nil {
+		t.Error("a viewer's change returned a save command")
+	}
+	if !reflect.DeepEqual(m.accounts, []types.Address{addr2}) {
+		t.Errorf("viewer accounts = %v, want the change kept for the session", m.accounts)
+	}
+	if want := []string{addr1.String(), addr2.String()}; !reflect.DeepEqual(watchList(cfg), want) {
+		t.Errorf("saved watch list = %v, want it unchanged", watchList(cfg))
+	}
+
+	operator := initial.WithOperator(true).(Model)
+	if _, cmd = operator.updateKeys(runeKey("x")); cmd == nil {
+		t.Error("an operator's change did not return a save command")
+	}
+}
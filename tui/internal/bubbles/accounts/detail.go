@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// Minimum balance requirements, these are the same for all current protocols.
+const (
+	minBalance           = 100000
+	minBalancePerAsset   = 100000
+	minBalancePerApp     = 100000
+	minBalancePerUint    = 25000 + 3500
+	minBalancePerBytes   = 25000 + 25000
+	minBalancePerPage    = 100000
+	minBalancePerBox     = 2500
+	minBalancePerBoxByte = 400
+)
+
+// accountMinBalance computes the minimum balance the account must hold.
+func accountMinBalance(info models.Account) uint64 {
+	return minBalance +
+		minBalancePerAsset*info.TotalAssetsOptedIn +
+		minBalancePerApp*(info.TotalCreatedApps+info.TotalAppsOptedIn) +
+		minBalancePerUint*info.AppsTotalSchema.NumUint +
+		minBalancePerBytes*info.AppsTotalSchema.NumByteSlice +
+		minBalancePerPage*info.AppsTotalExtraPages +
+		minBalancePerBox*info.TotalBoxes +
+		minBalancePerBoxByte*info.TotalBoxBytes
+}
+
+func formatAlgos(microAlgos uint64) string {
+	return fmt.Sprintf("%f Algos", float64(microAlgos)/1000000.0)
+}
+
+// statusSummary is the one line account summary shown in the list.
+func (m Model) statusSummary(info models.Account) string {
+	if info.Address == "" {
+		return ""
+	}
+	summary := fmt.Sprintf("%s, min %s", info.Status, formatAlgos(accountMinBalance(info)))
+	if info.AuthAddr != "" {
+		summary += ", rekeyed"
+	}
+	return summary
+}
+
+// detailString renders the full account information for the detail view,
+// along with the line where the transaction feed starts.
+func (m Model) detailString(addr types.Address) (string, int) {
+	acct, ok := m.Accounts[addr]
+	if !ok {
+		return "", 0
+	}
+	info := acct.Info
+	key := m.style.AccountYellowText
+	builder := strings.Builder{}
+
+	row := func(name, value string) {
+		builder.WriteString(fmt.Sprintf("  %s %s\n", key.Render(fmt.Sprintf("%-32s", name+":")), value))
+	}
+	section := func(title string) {
+		builder.WriteString(fmt.Sprintf("\n%s\n", m.style.AccountBoldText.Render(title)))
+	}
+
+	builder.WriteString(fmt.Sprintf("%s %s %s\n",
+		m.style.AccountBoldText.Render("Account:"),
+		m.style.AccountYellowText.Render(addr.String()),
+		m.style.AccountBoldText.Render(m.labelFor(addr))))
+	if info.Address == "" {
+		builder.WriteString("\n  Waiting for account information...\n")
+		return builder.String(), 0
+	}
+
+	section("Balance")
+	row("Status", info.Status)
+	row("Amount", formatAlgos(info.Amount))
+	row("Amount without pending rewards", formatAlgos(info.AmountWithoutPendingRewards))
+	row("Pending rewards", formatAlgos(info.PendingRewards))
+	row("Total rewards", formatAlgos(info.Rewards))
+	row("Minimum balance", formatAlgos(accountMinBalance(info)))
+	if len(acct.BalanceHistory) > 0 {
+		row("Since start", fmt.Sprintf("%s Algos", formatDelta(acct.Initial[0], acct.Balances[0], 6)))
+	}
+	if len(acct.BalanceHistory) > 1 {
+		row("History", m.style.AccountBlueText.Render(sparkline(acct.BalanceHistory)))
+	}
+	authAddr := info.AuthAddr
+	if authAddr == "" {
+		authAddr = "-"
+	}
+	row("Auth address (rekey)", authAddr)
+	row("Signature type", info.SigType)
+
+	if p, ok := m.participationFor(addr, info); ok {
+		section("Participation")
+		row("Vote first / last valid", fmt.Sprintf("%d / %d", p.keys.VoteFirstValid, p.keys.VoteLastValid))
+		row("Vote key dilution", fmt.Sprintf("%d", p.keys.VoteKeyDilution))
+		expiry := p.expiryString()
+		switch {
+		case p.expired():
+			expiry = m.style.StatusCriticalText.Render(expiry)
+		case p.expiring():
+			expiry = m.style.StatusWarningText.Render(expiry)
+		}
+		row("Expiry", expiry)
+		local := m.localKeyString(p)
+		if p.local == nil && m.participationErr == nil {
+			local = m.style.StatusCriticalText.Render(local)
+		}
+		row("Local key", local)
+		if p.local != nil {
+			row("Key ID", p.local.ID)
+			row("Last vote / proposal", fmt.Sprintf("%d / %d", p.local.LastVote, p.local.LastBlockProposal))
+		}
+	}
+
+	section("Assets")
+	row("Opted in", fmt.Sprintf("%d", info.TotalAssetsOptedIn))
+	row("Created", fmt.Sprintf("%d", info.TotalCreatedAssets))
+	for _, asset := range info.CreatedAssets {
+		row(fmt.Sprintf("  %d", asset.Index), fmt.Sprintf("%s (%s)", asset.Params.Name, asset.Params.UnitName))
+	}
+	if table := m.assetTable(acct); table != "" {
+		builder.WriteString("\n" + table)
+	}
+
+	section("Applications")
+	row("Opted in", fmt.Sprintf("%d", info.TotalAppsOptedIn))
+	optIns := make([]uint64, 0, len(info.AppsLocalState))
+	for _, local := range info.AppsLocalState {
+		optIns = append(optIns, local.Id)
+	}
+	sort.Slice(optIns, func(i, j int) bool { return optIns[i] < optIns[j] })
+	for _, id := range optIns {
+		row(fmt.Sprintf("  %d", id), "opted in")
+	}
+	row("Created", fmt.Sprintf("%d", info.TotalCreatedApps))
+	for _, app := range info.CreatedApps {
+		row(fmt.Sprintf("  %d", app.Id), "created")
+	}
+	row("Extra program pages", fmt.Sprintf("%d", info.AppsTotalExtraPages))
+	row("Schema uints / byte slices", fmt.Sprintf("%d / %d", info.AppsTotalSchema.NumUint, info.AppsTotalSchema.NumByteSlice))
+	row("Boxes", fmt.Sprintf("%d", info.TotalBoxes))
+	row("Box bytes", fmt.Sprintf("%d", info.TotalBoxBytes))
+
+	section("Transactions")
+	feedOffset := strings.Count(builder.String(), "\n")
+	if len(acct.Transactions) == 0 {
+		builder.WriteString("  No transactions seen in tailed blocks yet.\n")
+	}
+	builder.WriteString(m.feedView(acct.Transactions, m.feedSelected))
+
+	return m.style.Account.Render(builder.String()), feedOffset
+}
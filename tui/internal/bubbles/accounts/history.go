@@ -0,0 +1,152 @@
+package accounts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+
+	"github.com/algorand/node-ui/tui/internal/layout"
+)
+
+// defaultHistory is how many balance changes are kept when not configured.
+const defaultHistory = 60
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// observeBalances records the latest balances of an account. A new entry is
+// added to the history when the Algo balance changes.
+func (acct *account) observeBalances(balances map[uint64]uint64, now balance, historyLen int) {
+	if acct.Initial == nil {
+		acct.Initial = balances
+	}
+	acct.Balances = balances
+
+	history := acct.BalanceHistory
+	if len(history) > 0 && history[len(history)-1].MicroAlgos == now.MicroAlgos {
+		return
+	}
+	start := layout.Max(0, len(history)+1-historyLen)
+	acct.BalanceHistory = append(append([]balance{}, history[start:]...), now)
+}
+
+// sparkline renders the Algo balance history, oldest first.
+func sparkline(history []balance) string {
+	if len(history) == 0 {
+		return ""
+	}
+
+	lo, hi := history[0].MicroAlgos, history[0].MicroAlgos
+	for _, b := range history {
+		if b.MicroAlgos < lo {
+			lo = b.MicroAlgos
+		}
+		if b.MicroAlgos > hi {
+			hi = b.MicroAlgos
+		}
+	}
+
+	var builder strings.Builder
+	for _, b := range history {
+		idx := 0
+		if hi > lo {
+			idx = int(float64(b.MicroAlgos-lo) / float64(hi-lo) * float64(len(sparkTicks)-1))
+		}
+		builder.WriteRune(sparkTicks[idx])
+	}
+	return builder.String()
+}
+
+// formatAmount renders a base unit amount with the asset decimals.
+func formatAmount(amount uint64, decimals uint64) string {
+	if decimals == 0 {
+		return fmt.Sprintf("%d", amount)
+	}
+	digits := fmt.Sprintf("%0*d", decimals+1, amount)
+	split := len(digits) - int(decimals)
+	return digits[:split] + "." + digits[split:]
+}
+
+// formatDelta renders the signed difference between two amounts.
+func formatDelta(from, to uint64, decimals uint64) string {
+	switch {
+	case to > from:
+		return "+" + formatAmount(to-from, decimals)
+	case to < from:
+		return "-" + formatAmount(from-to, decimals)
+	default:
+		return "0"
+	}
+}
+
+// historySummary is the balance chart line shown in the list.
+func (m Model) historySummary(acct *account) string {
+	if len(acct.BalanceHistory) == 0 {
+		return ""
+	}
+	first := acct.BalanceHistory[0]
+	last := acct.BalanceHistory[len(acct.BalanceHistory)-1]
+	if len(acct.BalanceHistory) == 1 {
+		return m.style.AccountHelpText.Render(fmt.Sprintf("no changes since %s", first.TimeStamp.Format("15:04:05")))
+	}
+	return fmt.Sprintf("%s %s",
+		m.style.AccountBlueText.Render(sparkline(acct.BalanceHistory)),
+		m.style.AccountHelpText.Render(fmt.Sprintf("%s Algos since %s, last change %s",
+			formatDelta(acct.Initial[0], last.MicroAlgos, 6),
+			first.TimeStamp.Format("15:04:05"),
+			last.TimeStamp.Format("15:04:05"))))
+}
+
+// assetTable renders the asset holdings with their change since the session
+// started.
+func (m Model) assetTable(acct *account) string {
+	ids := make([]uint64, 0, len(acct.Balances))
+	for id := range acct.Balances {
+		// Algos are at the special index.
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	builder := strings.Builder{}
+	builder.WriteString(m.style.AccountBoldText.Render(fmt.Sprintf("  %-12s %-24s %24s %20s", "ID", "Name", "Balance", "Since start")))
+	builder.WriteString("\n")
+	for _, id := range ids {
+		params, known := m.assetParams[id]
+		name := "-"
+		if known {
+			name = assetName(params)
+		}
+		builder.WriteString(fmt.Sprintf("  %-12d %-24s %24s %20s\n",
+			id,
+			shorten(name, 24),
+			formatAmount(acct.Balances[id], params.Decimals),
+			formatDelta(acct.Initial[id], acct.Balances[id], params.Decimals)))
+	}
+	return builder.String()
+}
+
+// assetName combines the asset name and unit name.
+func assetName(params models.AssetParams) string {
+	switch {
+	case params.Name != "" && params.UnitName != "":
+		return fmt.Sprintf("%s (%s)", params.Name, params.UnitName)
+	case params.Name != "":
+		return params.Name
+	default:
+		return params.UnitName
+	}
+}
+
+// shorten abbreviates long names to fit in the table.
+func shorten(s string, n int) string {
+	if len([]rune(s)) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
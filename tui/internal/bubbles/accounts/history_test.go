@@ -0,0 +1,44 @@
+package accounts
+
+import "testing"
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount   uint64
+		decimals uint64
+		want     string
+	}{
+		{amount: 0, decimals: 0, want: "0"},
+		{amount: 42, decimals: 0, want: "42"},
+		{amount: 0, decimals: 6, want: "0.000000"},
+		{amount: 1, decimals: 6, want: "0.000001"},
+		{amount: 1234567, decimals: 6, want: "1.234567"},
+		{amount: 10000000000000000000, decimals: 6, want: "10000000000000.000000"},
+		{amount: 18446744073709551615, decimals: 19, want: "1.8446744073709551615"},
+		{amount: 5, decimals: 2, want: "0.05"},
+	}
+	for _, tt := range tests {
+		if got := formatAmount(tt.amount, tt.decimals); got != tt.want {
+			t.Errorf("formatAmount(%d, %d) = %s, want %s", tt.amount, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDelta(t *testing.T) {
+	tests := []struct {
+		from, to uint64
+		decimals uint64
+		want     string
+	}{
+		{from: 100, to: 100, decimals: 6, want: "0"},
+		{from: 1000000, to: 2500000, decimals: 6, want: "+1.500000"},
+		{from: 2500000, to: 1000000, decimals: 6, want: "-1.500000"},
+		{from: 0, to: 18446744073709551615, decimals: 0, want: "+18446744073709551615"},
+		{from: 18446744073709551615, to: 0, decimals: 0, want: "-18446744073709551615"},
+	}
+	for _, tt := range tests {
+		if got := formatDelta(tt.from, tt.to, tt.decimals); got != tt.want {
+			t.Errorf("formatDelta(%d, %d, %d) = %s, want %s", tt.from, tt.to, tt.decimals, got, tt.want)
+		}
+	}
+}
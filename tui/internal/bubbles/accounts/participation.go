@@ -0,0 +1,118 @@
+package accounts
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/messages"
+)
+
+// expiryWarning is how long before the vote last valid round an expiring
+// participation key is flagged.
+const expiryWarning = 7 * 24 * time.Hour
+
+// participation summarizes the participation state of an online account.
+type participation struct {
+	keys models.AccountParticipation
+	// remaining is the number of rounds until the keys expire, 0 once expired.
+	remaining uint64
+	eta       time.Duration
+	// local is the matching key installed on this node, if any.
+	local *messages.ParticipationKey
+}
+
+func (p participation) expired() bool {
+	return p.remaining == 0
+}
+
+func (p participation) expiring() bool {
+	return p.eta > 0 && p.eta < expiryWarning
+}
+
+// participationFor returns the participation state of an account, false if
+// the account is not online.
+func (m Model) participationFor(addr types.Address, info models.Account) (participation, bool) {
+	if info.Status != "Online" {
+		return participation{}, false
+	}
+
+	p := participation{keys: info.Participation}
+	round := m.round
+	if info.Round > round {
+		round = info.Round
+	}
+	if round < p.keys.VoteLastValid {
+		p.remaining = p.keys.VoteLastValid - round
+		p.eta = time.Duration(p.remaining) * m.blockTime
+	}
+
+	for i, key := range m.participationKeys {
+		if key.Address == addr.String() && bytes.Equal(key.Key.VoteParticipationKey, p.keys.VoteParticipationKey) {
+			p.local = &m.participationKeys[i]
+			break
+		}
+	}
+	return p, true
+}
+
+// formatETA renders a duration with a precision suited to its size.
+func formatETA(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "unknown"
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%.1f days", d.Hours()/24)
+	case d >= time.Hour:
+		return d.Round(time.Minute).String()
+	default:
+		return d.Round(time.Second).String()
+	}
+}
+
+// expiryString describes when the keys expire.
+func (p participation) expiryString() string {
+	if p.expired() {
+		return "expired"
+	}
+	return fmt.Sprintf("expires in %d rounds (~%s)", p.remaining, formatETA(p.eta))
+}
+
+// localKeyString describes whether this node holds the participation key.
+func (m Model) localKeyString(p participation) string {
+	switch {
+	case p.local != nil:
+		return "installed on this node"
+	case m.participationErr != nil:
+		return "unknown, " + m.participationErr.Error()
+	default:
+		return "online but no local key"
+	}
+}
+
+// participationSummary is the one line participation summary shown in the
+// list, it is empty for offline accounts.
+func (m Model) participationSummary(addr types.Address, info models.Account) string {
+	p, ok := m.participationFor(addr, info)
+	if !ok {
+		return ""
+	}
+
+	textStyle := m.style.AccountHelpText
+	if p.expiring() {
+		textStyle = m.style.StatusWarningText
+	}
+	summary := textStyle.Render(fmt.Sprintf("Keys %d-%d, dilution %d, %s",
+		p.keys.VoteFirstValid, p.keys.VoteLastValid, p.keys.VoteKeyDilution, p.expiryString()))
+
+	switch {
+	case p.expired():
+		summary = m.style.StatusCriticalText.Render(fmt.Sprintf("Keys %d-%d expired", p.keys.VoteFirstValid, p.keys.VoteLastValid))
+	case p.local == nil && m.participationErr == nil:
+		summary += "  " + m.style.StatusCriticalText.Render("online but no local key")
+	}
+	return summary
+}
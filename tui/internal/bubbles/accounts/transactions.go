@@ -0,0 +1,237 @@
+package accounts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/tui/internal/alerts"
+	"github.com/algorand/node-ui/tui/internal/bubbles/explorer"
+)
+
+// maxFeed is how many transactions are kept for each account.
+const maxFeed = 50
+
+// listFeed is how many transactions are shown for each account in the list.
+const listFeed = 3
+
+// feedItem is a transaction involving a watched account.
+type feedItem struct {
+	Round uint64
+	// Intra is the position of the top level transaction in the block, inner
+	// transactions refer to their parent.
+	Intra int
+	Inner bool
+	Time  time.Time
+	Type  types.TxType
+	// Outgoing is set when the watched account is the sender.
+	Outgoing     bool
+	Counterparty string
+	// Amount is in micro Algos when AssetID is 0.
+	Amount  uint64
+	AssetID uint64
+}
+
+// involves checks whether the account is a party to the transaction.
+func involves(txn types.Transaction, addr types.Address) bool {
+	return txn.Sender == addr ||
+		txn.Receiver == addr ||
+		txn.CloseRemainderTo == addr ||
+		txn.AssetReceiver == addr ||
+		txn.AssetCloseTo == addr ||
+		txn.AssetSender == addr
+}
+
+// counterparty is the other side of the transaction from the account's view.
+func counterparty(txn types.SignedTxnWithAD, addr types.Address) string {
+	if txn.Txn.Sender != addr {
+		return txn.Txn.Sender.String()
+	}
+
+	switch txn.Txn.Type {
+	case types.PaymentTx:
+		return txn.Txn.Receiver.String()
+	case types.AssetTransferTx:
+		return txn.Txn.AssetReceiver.String()
+	case types.ApplicationCallTx:
+		id := uint64(txn.Txn.ApplicationID)
+		if id == 0 {
+			id = txn.ApplyData.ApplicationID
+		}
+		return fmt.Sprintf("app %d", id)
+	case types.AssetConfigTx:
+		id := uint64(txn.Txn.ConfigAsset)
+		if id == 0 {
+			id = txn.ApplyData.ConfigAsset
+		}
+		return fmt.Sprintf("asset %d", id)
+	case types.AssetFreezeTx:
+		return txn.Txn.FreezeAccount.String()
+	default:
+		return "-"
+	}
+}
+
+// makeFeedItem extracts the feed entry of a transaction for the account.
+func makeFeedItem(txn types.SignedTxnWithAD, addr types.Address) feedItem {
+	item := feedItem{
+		Type:         txn.Txn.Type,
+		Outgoing:     txn.Txn.Sender == addr,
+		Counterparty: counterparty(txn, addr),
+	}
+	switch txn.Txn.Type {
+	case types.PaymentTx:
+		item.Amount = uint64(txn.Txn.Amount)
+	case types.AssetTransferTx:
+		item.Amount = txn.Txn.AssetAmount
+		item.AssetID = uint64(txn.Txn.XferAsset)
+	}
+	return item
+}
+
+// collectTransactions finds the transactions, including inner transactions,
+// which involve the account.
+func collectTransactions(txns []types.SignedTxnWithAD, addr types.Address, inner bool) []feedItem {
+	var items []feedItem
+	for _, txn := range txns {
+		if involves(txn.Txn, addr) {
+			item := makeFeedItem(txn, addr)
+			item.Inner = inner
+			items = append(items, item)
+		}
+		items = append(items, collectTransactions(txn.EvalDelta.InnerTxns, addr, true)...)
+	}
+	return items
+}
+
+// blockTransactions finds the transactions in a block which involve the
+// account, newest first.
+func blockTransactions(block explorer.BlockItem, addr types.Address) []feedItem {
+	var items []feedItem
+	timestamp := time.Unix(block.Block.Block.TimeStamp, 0)
+	for intra, txn := range block.Block.Block.Payset {
+		found := collectTransactions([]types.SignedTxnWithAD{txn.SignedTxnWithAD}, addr, false)
+		for i := range found {
+			found[i].Round = block.Round
+			found[i].Intra = intra
+			found[i].Time = timestamp
+		}
+		items = append(found, items...)
+	}
+	return items
+}
+
+// observeBlocks adds the watched account transactions to the feeds and
+// returns the outgoing payments. Blocks which were already seen are skipped.
+func (m *Model) observeBlocks(blocks []explorer.BlockItem) []alerts.Payment {
+	var payments []alerts.Payment
+	latest := m.lastRound
+	m.copyAccounts()
+	for _, block := range blocks {
+		if block.Round <= m.lastRound {
+			continue
+		}
+		if block.Round > latest {
+			latest = block.Round
+		}
+		for addr, acct := range m.Accounts {
+			items := blockTransactions(block, addr)
+			if len(items) == 0 {
+				continue
+			}
+			for _, item := range items {
+				if item.Outgoing && (item.Type == types.PaymentTx || item.Type == types.AssetTransferTx) {
+					payments = append(payments, alerts.Payment{
+						Account:  addr,
+						Round:    item.Round,
+						Type:     item.Type,
+						Receiver: m.displayName(item.Counterparty),
+						Amount:   m.amountString(item),
+					})
+				}
+			}
+			feed := append(items, acct.Transactions...)
+			// Blocks may arrive out of order, keep the feed newest first.
+			sortFeed(feed)
+			acct.Transactions = feed[:min(len(feed), maxFeed)]
+		}
+	}
+	m.lastRound = latest
+	return payments
+}
+
+// sortFeed orders the feed newest first.
+func sortFeed(feed []feedItem) {
+	// Insertion sort, the feed is nearly sorted.
+	for i := 1; i < len(feed); i++ {
+		for j := i; j > 0 && feed[j].Round > feed[j-1].Round; j-- {
+			feed[j], feed[j-1] = feed[j-1], feed[j]
+		}
+	}
+}
+
+// amountString renders the transferred amount, using the asset decimals when
+// they are known.
+func (m Model) amountString(item feedItem) string {
+	switch {
+	case item.Type != types.PaymentTx && item.Type != types.AssetTransferTx:
+		return "-"
+	case item.AssetID == 0:
+		return formatAmount(item.Amount, 6) + " Algos"
+	}
+	params, ok := m.assetParams[item.AssetID]
+	if !ok {
+		return fmt.Sprintf("%d of asset %d", item.Amount, item.AssetID)
+	}
+	unit := params.UnitName
+	if unit == "" {
+		unit = fmt.Sprintf("asset %d", item.AssetID)
+	}
+	return fmt.Sprintf("%s %s", formatAmount(item.Amount, params.Decimals), unit)
+}
+
+// feedRow renders a single transaction of the feed.
+func (m Model) feedRow(item feedItem) string {
+	direction := "in "
+	if item.Outgoing {
+		direction = "out"
+	}
+	txType := string(item.Type)
+	if item.Inner {
+		txType += "*"
+	}
+	return fmt.Sprintf("%-7s %s %-12s %-22s %10d %s",
+		txType,
+		direction,
+		shorten(m.displayName(item.Counterparty), 12),
+		m.amountString(item),
+		item.Round,
+		item.Time.Format("15:04:05"))
+}
+
+// feedView renders the transaction feed, marking the selected transaction
+// when selected is not negative.
+func (m Model) feedView(feed []feedItem, selected int) string {
+	builder := strings.Builder{}
+	for i, item := range feed {
+		cursor := " "
+		if i == selected {
+			cursor = ">"
+		}
+		row := m.feedRow(item)
+		if i == selected {
+			row = m.style.AccountYellowText.Render(row)
+		}
+		builder.WriteString(fmt.Sprintf("  %s %s\n", m.style.BottomListItemSelector.Copy().MarginLeft(0).Render(cursor), row))
+	}
+	return builder.String()
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
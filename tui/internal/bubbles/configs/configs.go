@@ -1,115 +1,493 @@
 package configs
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/constants"
+	"github.com/algorand/node-ui/tui/internal/layout"
+	"github.com/algorand/node-ui/tui/internal/style"
 )
 
-var (
-	titleStyle = func() lipgloss.Style {
-		b := lipgloss.RoundedBorder()
-		b.Right = "├"
-		return lipgloss.NewStyle().BorderStyle(b).Padding(0, 1)
-	}()
-
-	infoStyle = func() lipgloss.Style {
-		b := lipgloss.RoundedBorder()
-		b.Left = "┤"
-		return titleStyle.Copy().BorderStyle(b)
-	}()
-)
+// valueWidth is the width of the value and default columns.
+const valueWidth = 24
+
+// row is a setting with its effective and default values.
+type row struct {
+	Key     string
+	Value   string
+	Default string
+	// Overridden is set when config.json has a value other than the default.
+	Overridden bool
+	// Known is false for keys which are not in the defaults table.
+	Known       bool
+	Description string
+}
 
 // Model representing the configs page.
 type Model struct {
+	style        *style.Styles
+	requestor    *messages.Requestor
 	heightMargin int
 	viewport     viewport.Model
+
+	// source, path and version describe the loaded config.json.
+	source  string
+	path    string
+	version int
+	err     error
+	rows    []row
+
+	// visible are the rows matching the filter.
+	visible        []row
+	selected       int
+	filtering      bool
+	filter         textinput.Model
+	overriddenOnly bool
+
+	// writable enables editing, editKey is the setting being typed. Viewers
+	// can never edit.
+	writable bool
+	viewer   bool
+	editing  bool
+	editKey  string
+	input    textinput.Model
+	editErr  error
+	// saved describes the last save, restartRequired is set after any save
+	// since algod only reads config.json on startup.
+	saved           string
+	saveErr         error
+	restartRequired bool
+
+	// view selects between the settings, the data directory files and the
+	// file viewer.
+	view         view
+	files        []messages.DataFile
+	filesErr     error
+	fileSelected int
+	openFile     messages.DataFile
+	fileContent  string
+	fileErr      error
 }
 
-// New creates a Model.
-func New(heightMargin int) Model {
+// New creates a Model. Settings can only be edited when writable is set.
+func New(style *style.Styles, requestor *messages.Requestor, initialHeight, heightMargin int, writable bool) Model {
+	filter := textinput.New()
+	filter.Prompt = "Filter: "
+	filter.Placeholder = "part of a setting name"
+	filter.CharLimit = 64
+
+	input := textinput.New()
+	input.CharLimit = 256
+
 	m := Model{
+		style:        style,
+		requestor:    requestor,
 		viewport:     viewport.New(0, 0),
 		heightMargin: heightMargin,
+		filter:       filter,
+		input:        input,
+		writable:     writable,
 	}
-	m.setSize(80, 20)
+	m.setSize(80, initialHeight)
 	return m
 }
 
-// ConfigContent allows the update function to find its config content.
-type ConfigContent string
+// Init is part of the tea.Model interface.
+func (m Model) Init() tea.Cmd {
+	return m.requestor.GetNodeConfigCmd()
+}
 
-func (m Model) getContent() tea.Cmd {
-	return func() tea.Msg {
-		return ConfigContent(messages.GetConfigs())
-	}
+// WithOperator returns the Model for a session, settings can only be edited
+// by operators.
+func (m Model) WithOperator(operator bool) tea.Model {
+	m.viewer = !operator
+	return m
 }
 
-// Init is part of the tea.Model interface.
-func (m Model) Init() tea.Cmd {
-	return m.getContent()
+// CapturesInput is true while the filter or a setting is being typed, so
+// that global key bindings are not triggered.
+func (m Model) CapturesInput() bool {
+	return m.filtering || m.editing
 }
 
 func (m *Model) setSize(width, height int) {
 	headerHeight := lipgloss.Height(m.headerView())
-	footerHeight := lipgloss.Height(m.footerView())
+	footerHeight := lipgloss.Height(m.promptView()) + lipgloss.Height(m.footerView())
 
 	m.viewport.Width = width
-	m.viewport.Height = height - m.heightMargin - headerHeight - footerHeight
+	// The viewport panics when following with a negative height.
+	m.viewport.Height = layout.Max(0, height-m.heightMargin-headerHeight-footerHeight)
+}
+
+// formatValue renders a setting the way it is written in config.json.
+func formatValue(value interface{}) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// configVersion is the Version of config.json, the latest known version is
+// used when it is missing.
+func configVersion(values map[string]interface{}) int {
+	if number, ok := values["Version"].(json.Number); ok {
+		if version, err := strconv.Atoi(number.String()); err == nil {
+			return version
+		}
+	}
+	return latestVersion
+}
+
+// buildRows merges config.json with the defaults for its version, sorted by
+// key.
+func buildRows(values map[string]interface{}, version int) []row {
+	var rows []row
+	known := make(map[string]bool, len(settings))
+	for _, s := range settings {
+		known[s.Name] = true
+		def, ok := s.defaultFor(version)
+		value, set := values[s.Name]
+		if !ok && !set {
+			continue
+		}
+		r := row{Key: s.Name, Known: ok, Description: descriptions[s.Name], Default: "-"}
+		if ok {
+			r.Default = formatValue(def)
+			r.Value = r.Default
+		}
+		if set {
+			r.Value = formatValue(value)
+			r.Overridden = r.Value != r.Default
+		}
+		rows = append(rows, r)
+	}
+	for name, value := range values {
+		if known[name] || name == "Version" {
+			continue
+		}
+		rows = append(rows, row{Key: name, Value: formatValue(value), Default: "-", Overridden: true})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+	return rows
+}
+
+// applyFilter selects the visible rows, keeping the selection in range.
+func (m *Model) applyFilter() {
+	filter := strings.ToLower(strings.TrimSpace(m.filter.Value()))
+	m.visible = nil
+	for _, r := range m.rows {
+		if m.overriddenOnly && !r.Overridden {
+			continue
+		}
+		if filter != "" && !strings.Contains(strings.ToLower(r.Key), filter) {
+			continue
+		}
+		m.visible = append(m.visible, r)
+	}
+	if m.selected >= len(m.visible) {
+		m.selected = layout.Max(0, len(m.visible)-1)
+	}
+	m.setContent()
+}
+
+// setContent renders the current view into the viewport.
+func (m *Model) setContent() {
+	switch m.view {
+	case settingsView:
+		m.viewport.SetContent(m.buildString())
+		m.scrollTo(m.selected)
+	case filesView:
+		m.viewport.SetContent(m.filesString())
+		m.scrollTo(m.fileSelected)
+	case fileView:
+		m.viewport.SetContent(m.fileContent)
+	}
+}
+
+// scrollTo keeps the selected row visible, each row is one line.
+func (m *Model) scrollTo(line int) {
+	switch {
+	case line < m.viewport.YOffset:
+		m.viewport.SetYOffset(line)
+	case line >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(line - m.viewport.Height + 1)
+	}
+}
+
+// keyWidth is the width of the key column.
+func (m Model) keyWidth() int {
+	width := len("Key")
+	for _, r := range m.rows {
+		width = layout.Max(width, len(r.Key))
+	}
+	return width
+}
+
+func (m Model) buildString() string {
+	if m.err != nil && !errors.Is(m.err, fs.ErrNotExist) {
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to read node settings: %s", m.err))
+	}
+	if len(m.visible) == 0 {
+		return "No matching settings."
+	}
+
+	keyWidth := m.keyWidth()
+	builder := strings.Builder{}
+	for i, r := range m.visible {
+		cursor := " "
+		if i == m.selected {
+			cursor = ">"
+		}
+		marker := " "
+		switch {
+		case !r.Known:
+			marker = "?"
+		case r.Overridden:
+			marker = "*"
+		}
+		value := fmt.Sprintf("%-*s", valueWidth, shorten(r.Value, valueWidth))
+		if r.Overridden {
+			value = m.style.AccountYellowText.Render(value)
+		}
+		builder.WriteString(fmt.Sprintf("%s %s %-*s %s %s\n",
+			m.style.BottomListItemSelector.Copy().MarginLeft(0).Render(cursor),
+			marker,
+			keyWidth, r.Key,
+			value,
+			m.style.AccountGrayText.Render(shorten(r.Default, valueWidth))))
+	}
+	return builder.String()
+}
+
+// shorten abbreviates long values to fit in the table.
+func shorten(s string, n int) string {
+	if len([]rune(s)) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
+
+// updateKeys handles the filter and navigation key bindings.
+func (m Model) updateKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.filtering {
+		switch msg.Type {
+		case tea.KeyEsc:
+			m.filter.Reset()
+			fallthrough
+		case tea.KeyEnter:
+			m.filtering = false
+			m.filter.Blur()
+		default:
+			m.filter, cmd = m.filter.Update(msg)
+		}
+		m.applyFilter()
+		return m, cmd
+	}
+	if m.editing {
+		return m.updateEdit(msg)
+	}
+	m.saved, m.saveErr = "", nil
+	if m.view != settingsView {
+		return m.updateFileKeys(msg)
+	}
+
+	switch {
+	case key.Matches(msg, constants.Keys.DataFiles):
+		m.view = filesView
+		m.setContent()
+		return m, m.requestor.GetDataFilesCmd()
+	case key.Matches(msg, constants.Keys.EditSetting):
+		return m, m.startEdit()
+	case key.Matches(msg, constants.Keys.Filter):
+		m.filtering = true
+		return m, m.filter.Focus()
+	case key.Matches(msg, constants.Keys.ToggleOverridden):
+		m.overriddenOnly = !m.overriddenOnly
+		m.applyFilter()
+		return m, nil
+	case key.Matches(msg, constants.Keys.Up):
+		if m.selected > 0 {
+			m.selected--
+		}
+	case key.Matches(msg, constants.Keys.Down):
+		if m.selected < len(m.visible)-1 {
+			m.selected++
+		}
+	default:
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	m.setContent()
+	return m, nil
 }
 
 // Update is part of the tea.Model interface.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	var (
-		cmd  tea.Cmd
-		cmds []tea.Cmd
-	)
+	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
-	case ConfigContent:
-		// For some reason tabs make the viewport go crazy
-		//m.viewport.SetContent(string(msg))
-		m.viewport.SetContent(strings.ReplaceAll(string(msg), "\t", "    "))
+	case tea.KeyMsg:
+		return m.updateKeys(msg)
+
+	case messages.NodeConfigMsg:
+		m.source, m.path, m.err = msg.Source, msg.Path, msg.Err
+		m.version = configVersion(msg.Values)
+		m.rows = nil
+		// A missing config.json means algod uses the defaults.
+		if msg.Err == nil || errors.Is(msg.Err, fs.ErrNotExist) {
+			m.rows = buildRows(msg.Values, m.version)
+		}
+		m.applyFilter()
+
+	case messages.NodeConfigSavedMsg:
+		if msg.Err != nil {
+			m.saveErr = fmt.Errorf("unable to save config.json: %w", msg.Err)
+			return m, nil
+		}
+		m.saved = "Saved config.json"
+		if msg.Backup != "" {
+			m.saved += ", the previous file is at " + msg.Backup
+		}
+		m.restartRequired = true
+		return m, m.requestor.GetNodeConfigCmd()
+
+	case messages.DataFilesMsg:
+		m.files, m.filesErr = msg.Files, msg.Err
+		if m.fileSelected >= len(m.files) {
+			m.fileSelected = layout.Max(0, len(m.files)-1)
+		}
+		m.setContent()
+
+	case renderedFileMsg:
+		if msg.err != nil {
+			m.fileErr = msg.err
+			return m, nil
+		}
+		m.view = fileView
+		m.openFile, m.fileContent = msg.file, msg.content
+		m.setContent()
+		m.viewport.GotoTop()
 
 	case tea.WindowSizeMsg:
 		m.setSize(msg.Width, msg.Height)
 	}
 
-	// Handle keyboard and mouse events in the viewport
+	// Handle mouse events in the viewport
 	m.viewport, cmd = m.viewport.Update(msg)
-	cmds = append(cmds, cmd)
-
-	return m, tea.Batch(cmds...)
+	return m, cmd
 }
 
 // View is part of the tea.Model interface.
 func (m Model) View() string {
-
-	builder := strings.Builder{}
-	builder.WriteString(fmt.Sprintf("%s\n%s\n%s", m.headerView(), m.viewport.View(), m.footerView()))
-	return builder.String()
+	return fmt.Sprintf("%s\n%s\n%s\n%s", m.headerView(), m.viewport.View(), m.promptView(), m.footerView())
 }
 
 func (m Model) headerView() string {
-	title := titleStyle.Render("Node configurations")
-	line := strings.Repeat("─", max(0, m.viewport.Width-lipgloss.Width(title)))
-	return lipgloss.JoinHorizontal(lipgloss.Center, title, line)
+	switch m.view {
+	case filesView:
+		title := "Data directory files"
+		if m.source == messages.SourceAPI {
+			title = "Node files from the API"
+		}
+		return m.titleView(title,
+			fmt.Sprintf("  %-28s %10s  %-16s  %s", "File", "Size", "Modified", "Contents"))
+	case fileView:
+		return m.titleView(fmt.Sprintf("%s (from %s)", m.openFile.Name, m.openFile.Source), "  "+m.openFile.Description)
+	}
+
+	text := "Node configuration"
+	switch {
+	case errors.Is(m.err, fs.ErrNotExist):
+		text += fmt.Sprintf(" (%s not found, showing version %d defaults)", m.path, m.version)
+	case m.err != nil:
+		text += fmt.Sprintf(" (from %s: %s)", m.source, m.path)
+	case m.path != "":
+		text += fmt.Sprintf(" (from %s: %s, version %d)", m.source, m.path, m.version)
+	}
+	if m.restartRequired {
+		text += m.style.StatusWarningText.Render(" • restart algod to apply changes")
+	}
+	return m.titleView(text, fmt.Sprintf("    %-*s %-*s %s", m.keyWidth(), "Key", valueWidth, "Value", "Default"))
 }
 
-func (m Model) footerView() string {
-	info := infoStyle.Render(fmt.Sprintf("%3.f%%", m.viewport.ScrollPercent()*100))
-	line := strings.Repeat("─", max(0, m.viewport.Width-lipgloss.Width(info)))
-	return lipgloss.JoinHorizontal(lipgloss.Center, line, info)
+// titleView renders the title with a second header line.
+func (m Model) titleView(text, header string) string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		layout.Header(text, m.viewport.Width),
+		m.style.AccountBoldText.Render(header))
 }
 
-func max(a, b int) int {
-	if a > b {
-		return a
+// promptView shows the filter input, or the key bindings after the
+// description of the selected setting.
+func (m Model) promptView() string {
+	switch {
+	case m.filtering:
+		return m.filter.View()
+	case m.editing && m.editErr != nil:
+		return fmt.Sprintf("%s  %s", m.input.View(), m.style.StatusCriticalText.Render(m.editErr.Error()))
+	case m.editing:
+		return m.input.View()
+	case m.saveErr != nil:
+		return m.style.StatusCriticalText.Render(m.saveErr.Error())
+	case m.saved != "":
+		return m.style.AccountHelpText.Render(m.saved)
+	}
+
+	k := constants.Keys
+	switch m.view {
+	case filesView:
+		if m.fileErr != nil {
+			return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to open file: %s", m.fileErr))
+		}
+		return m.style.AccountHelpText.Render(fmt.Sprintf("%s: view file • %s: back to settings",
+			k.Forward.Help().Key, k.Back.Help().Key))
+	case fileView:
+		return m.style.AccountHelpText.Render(fmt.Sprintf("%s: scroll • %s: back to files",
+			k.Generic.Help().Key, k.Back.Help().Key))
+	}
+
+	only := "overridden only"
+	if m.overriddenOnly {
+		only = "all settings"
 	}
-	return b
+	hint := fmt.Sprintf("%s: %s • %s: %s • %s: %s",
+		k.Filter.Help().Key, k.Filter.Help().Desc,
+		k.ToggleOverridden.Help().Key, only,
+		k.DataFiles.Help().Key, k.DataFiles.Help().Desc)
+	if m.writable && !m.viewer && m.source == messages.SourceDisk {
+		hint += fmt.Sprintf(" • %s: %s", k.EditSetting.Help().Key, k.EditSetting.Help().Desc)
+	}
+	if m.selected < len(m.visible) {
+		selected := m.visible[m.selected]
+		switch {
+		case !selected.Known:
+			hint = "Not a known setting for this config version. • " + hint
+		case selected.Description != "":
+			hint = selected.Description + " • " + hint
+		}
+	}
+	return m.style.AccountHelpText.Render(hint)
+}
+
+func (m Model) footerView() string {
+	return layout.Footer(m.viewport.ScrollPercent(), m.viewport.Width)
 }
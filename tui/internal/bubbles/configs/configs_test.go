@@ -0,0 +1,17 @@
+package configs
+
+import (
+	"testing"
+
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+func TestSetSize(t *testing.T) {
+	m := New(style.DefaultStyles(), nil, 40, 20, false)
+	for _, height := range []int{40, 3, 0} {
+		m.setSize(80, height)
+		if m.viewport.Height < 0 {
+			t.Errorf("viewport height for a %d line window = %d", height, m.viewport.Height)
+		}
+	}
+}
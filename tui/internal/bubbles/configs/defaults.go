@@ -0,0 +1,78 @@
+package configs
+
+// The settings table in defaults_gen.go is generated from a go-algorand
+// checkout, set GO_ALGORAND to its path and run go generate.
+//go:generate go run ./internal/defaultsgen -template $GO_ALGORAND/config/localTemplate.go -out defaults_gen.go
+
+// versionedDefault is the default value of a setting starting with a config
+// version.
+type versionedDefault struct {
+	Version int
+	Value   interface{}
+}
+
+// setting describes a config.json key. Type is the Go type of the Local
+// config field, defaults are bool, string, int64 for signed numbers and
+// durations in nanoseconds, or uint64 for unsigned numbers.
+type setting struct {
+	Name string
+	Type string
+	// Defaults are ordered by version, the key does not exist before the
+	// first version.
+	Defaults []versionedDefault
+}
+
+// integer is the range of an integer setting type.
+type integer struct {
+	signed bool
+	bits   int
+}
+
+// integers are the integer types of the Local config. algod does not start
+// when a config.json value does not fit the type of the setting.
+var integers = map[string]integer{
+	"int":           {signed: true, bits: 64},
+	"int64":         {signed: true, bits: 64},
+	"time.Duration": {signed: true, bits: 64},
+	"uint":          {bits: 64},
+	"uint32":        {bits: 32},
+	"uint64":        {bits: 64},
+}
+
+// defaultFor returns the default value for a config version, or false if
+// the setting did not exist yet.
+func (s setting) defaultFor(version int) (interface{}, bool) {
+	var value interface{}
+	found := false
+	for _, d := range s.Defaults {
+		if d.Version > version {
+			break
+		}
+		value, found = d.Value, true
+	}
+	return value, found
+}
+
+// descriptions are short explanations of the commonly changed settings,
+// shown when the setting is selected.
+var descriptions = map[string]string{
+	"Archival":                 "Keep every block. Archival nodes can not use fast catchup.",
+	"BaseLoggerDebugLevel":     "Log level, 4 is info and 5 is debug.",
+	"CatchpointInterval":       "Rounds between catchpoints, 0 disables them.",
+	"CatchpointTracking":       "-1 disables catchpoints, 0 tracks them on archival nodes, 1 always tracks them and 2 also writes catchpoint files.",
+	"CatchupParallelBlocks":    "Blocks fetched in parallel during catchup.",
+	"ColdDataDir":              "Directory for data which is rarely read, defaults to the data directory.",
+	"DNSBootstrapID":           "DNS names used to find relays, <network> is replaced by the network name.",
+	"EnableDeveloperAPI":       "Enable the developer endpoints such as teal compile and dryrun.",
+	"EnableFollowMode":         "Run as a follower node which does not participate in consensus.",
+	"EnableMetricReporting":    "Serve Prometheus metrics on NodeExporterListenAddress.",
+	"EnableProfiler":           "Serve pprof endpoints on the REST API.",
+	"EndpointAddress":          "Address and port of the REST API.",
+	"GossipFanout":             "Number of relays a non-relay node connects to.",
+	"HotDataDir":               "Directory for frequently accessed data, defaults to the data directory.",
+	"IncomingConnectionsLimit": "Maximum incoming connections, relays only.",
+	"LogSizeLimit":             "Size in bytes at which node.log is archived.",
+	"NetAddress":               "Address to listen on for gossip connections, set on relays.",
+	"PublicAddress":            "Public address of a relay, reported to telemetry.",
+	"TxPoolSize":               "Maximum transactions in the pool.",
+}
@@ -0,0 +1,160 @@
+// Code generated by defaultsgen from go-algorand config/localTemplate.go. DO NOT EDIT.
+
+package configs
+
+// latestVersion is the newest config version in the defaults table. It is
+// used when config.json does not have a Version.
+const latestVersion = 38
+
+// settings are the algod config.json defaults, from the version tags of the
+// go-algorand Local config struct.
+var settings = []setting{
+	{Name: "AccountUpdatesStatsInterval", Type: "time.Duration", Defaults: []versionedDefault{{16, int64(5000000000)}}},
+	{Name: "AccountsRebuildSynchronousMode", Type: "int", Defaults: []versionedDefault{{12, int64(1)}}},
+	{Name: "AgreementIncomingBundlesQueueLength", Type: "uint64", Defaults: []versionedDefault{{21, uint64(7)}, {27, uint64(15)}}},
+	{Name: "AgreementIncomingProposalsQueueLength", Type: "uint64", Defaults: []versionedDefault{{21, uint64(25)}, {27, uint64(50)}}},
+	{Name: "AgreementIncomingVotesQueueLength", Type: "uint64", Defaults: []versionedDefault{{21, uint64(10000)}, {27, uint64(20000)}}},
+	{Name: "AnnounceParticipationKey", Type: "bool", Defaults: []versionedDefault{{4, true}}},
+	{Name: "Archival", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "BaseLoggerDebugLevel", Type: "uint32", Defaults: []versionedDefault{{0, uint64(1)}, {1, uint64(4)}}},
+	{Name: "BlockDBDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "BlockServiceCustomFallbackEndpoints", Type: "string", Defaults: []versionedDefault{{16, ""}}},
+	{Name: "BlockServiceMemCap", Type: "uint64", Defaults: []versionedDefault{{28, uint64(500000000)}}},
+	{Name: "BroadcastConnectionsLimit", Type: "int", Defaults: []versionedDefault{{4, int64(-1)}}},
+	{Name: "CadaverDirectory", Type: "string", Defaults: []versionedDefault{{27, ""}}},
+	{Name: "CadaverSizeTarget", Type: "uint64", Defaults: []versionedDefault{{0, uint64(1073741824)}, {24, uint64(0)}}},
+	{Name: "CatchpointDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "CatchpointFileHistoryLength", Type: "int", Defaults: []versionedDefault{{7, int64(365)}}},
+	{Name: "CatchpointInterval", Type: "uint64", Defaults: []versionedDefault{{7, uint64(10000)}}},
+	{Name: "CatchpointTracking", Type: "int64", Defaults: []versionedDefault{{11, int64(0)}}},
+	{Name: "CatchupBlockDownloadRetryAttempts", Type: "int", Defaults: []versionedDefault{{9, int64(1000)}}},
+	{Name: "CatchupBlockValidateMode", Type: "int", Defaults: []versionedDefault{{16, int64(0)}}},
+	{Name: "CatchupFailurePeerRefreshRate", Type: "int", Defaults: []versionedDefault{{0, int64(10)}}},
+	{Name: "CatchupGossipBlockFetchTimeoutSec", Type: "int", Defaults: []versionedDefault{{9, int64(4)}}},
+	{Name: "CatchupHTTPBlockFetchTimeoutSec", Type: "int", Defaults: []versionedDefault{{9, int64(4)}}},
+	{Name: "CatchupLedgerDownloadRetryAttempts", Type: "int", Defaults: []versionedDefault{{9, int64(50)}}},
+	{Name: "CatchupParallelBlocks", Type: "uint64", Defaults: []versionedDefault{{3, uint64(50)}, {5, uint64(16)}}},
+	{Name: "ColdDataDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "ConnectionsRateLimitingCount", Type: "uint", Defaults: []versionedDefault{{4, uint64(60)}}},
+	{Name: "ConnectionsRateLimitingWindowSeconds", Type: "uint", Defaults: []versionedDefault{{4, uint64(1)}}},
+	{Name: "CrashDBDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "DHTMode", Type: "string", Defaults: []versionedDefault{{38, ""}}},
+	{Name: "DNSBootstrapID", Type: "string", Defaults: []versionedDefault{{0, "<network>.algorand.network"}, {28, "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)"}}},
+	{Name: "DNSSecurityFlags", Type: "uint32", Defaults: []versionedDefault{{6, uint64(1)}, {34, uint64(9)}}},
+	{Name: "DeadlockDetection", Type: "int", Defaults: []versionedDefault{{1, int64(0)}}},
+	{Name: "DeadlockDetectionThreshold", Type: "int", Defaults: []versionedDefault{{20, int64(30)}}},
+	{Name: "DisableAPIAuth", Type: "bool", Defaults: []versionedDefault{{30, false}}},
+	{Name: "DisableLedgerLRUCache", Type: "bool", Defaults: []versionedDefault{{27, false}}},
+	{Name: "DisableLocalhostConnectionRateLimit", Type: "bool", Defaults: []versionedDefault{{16, true}}},
+	{Name: "DisableNetworking", Type: "bool", Defaults: []versionedDefault{{16, false}}},
+	{Name: "DisableOutgoingConnectionThrottling", Type: "bool", Defaults: []versionedDefault{{5, false}}},
+	{Name: "EnableAccountUpdatesStats", Type: "bool", Defaults: []versionedDefault{{16, false}}},
+	{Name: "EnableAgreementReporting", Type: "bool", Defaults: []versionedDefault{{3, false}}},
+	{Name: "EnableAgreementTimeMetrics", Type: "bool", Defaults: []versionedDefault{{3, false}}},
+	{Name: "EnableAssembleStats", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "EnableBatchVerification", Type: "bool", Defaults: []versionedDefault{{37, true}}},
+	{Name: "EnableBlockService", Type: "bool", Defaults: []versionedDefault{{7, false}}},
+	{Name: "EnableDHTProviders", Type: "bool", Defaults: []versionedDefault{{34, false}}},
+	{Name: "EnableDeveloperAPI", Type: "bool", Defaults: []versionedDefault{{9, false}}},
+	{Name: "EnableExperimentalAPI", Type: "bool", Defaults: []versionedDefault{{26, false}}},
+	{Name: "EnableFollowMode", Type: "bool", Defaults: []versionedDefault{{27, false}}},
+	{Name: "EnableGossipBlockService", Type: "bool", Defaults: []versionedDefault{{8, true}}},
+	{Name: "EnableGossipService", Type: "bool", Defaults: []versionedDefault{{33, true}}},
+	{Name: "EnableIncomingMessageFilter", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "EnableLedgerService", Type: "bool", Defaults: []versionedDefault{{7, false}}},
+	{Name: "EnableMetricReporting", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "EnableNetDevMetrics", Type: "bool", Defaults: []versionedDefault{{34, false}}},
+	{Name: "EnableOutgoingNetworkMessageFiltering", Type: "bool", Defaults: []versionedDefault{{0, true}}},
+	{Name: "EnableP2P", Type: "bool", Defaults: []versionedDefault{{31, false}}},
+	{Name: "EnableP2PHybridMode", Type: "bool", Defaults: []versionedDefault{{34, false}}},
+	{Name: "EnablePingHandler", Type: "bool", Defaults: []versionedDefault{{6, true}}},
+	{Name: "EnablePrivateNetworkAccessHeader", Type: "bool", Defaults: []versionedDefault{{35, false}}},
+	{Name: "EnableProcessBlockStats", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "EnableProfiler", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "EnableRequestLogger", Type: "bool", Defaults: []versionedDefault{{4, false}}},
+	{Name: "EnableRuntimeMetrics", Type: "bool", Defaults: []versionedDefault{{22, false}}},
+	{Name: "EnableTopAccountsReporting", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "EnableTxBacklogAppRateLimiting", Type: "bool", Defaults: []versionedDefault{{32, true}}},
+	{Name: "EnableTxBacklogRateLimiting", Type: "bool", Defaults: []versionedDefault{{27, false}, {30, true}}},
+	{Name: "EnableTxnEvalTracer", Type: "bool", Defaults: []versionedDefault{{27, false}}},
+	{Name: "EnableUsageLog", Type: "bool", Defaults: []versionedDefault{{24, false}}},
+	{Name: "EnableVerbosedTransactionSyncLogging", Type: "bool", Defaults: []versionedDefault{{17, false}}},
+	{Name: "EnableVoteCompression", Type: "bool", Defaults: []versionedDefault{{36, true}}},
+	{Name: "EndpointAddress", Type: "string", Defaults: []versionedDefault{{0, "127.0.0.1:0"}}},
+	{Name: "FallbackDNSResolverAddress", Type: "string", Defaults: []versionedDefault{{0, ""}}},
+	{Name: "ForceFetchTransactions", Type: "bool", Defaults: []versionedDefault{{17, false}}},
+	{Name: "ForceRelayMessages", Type: "bool", Defaults: []versionedDefault{{0, false}}},
+	{Name: "GoMemLimit", Type: "uint64", Defaults: []versionedDefault{{34, uint64(0)}}},
+	{Name: "GossipFanout", Type: "int", Defaults: []versionedDefault{{0, int64(4)}}},
+	{Name: "HeartbeatUpdateInterval", Type: "int", Defaults: []versionedDefault{{27, int64(600)}}},
+	{Name: "HotDataDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "IncomingConnectionsLimit", Type: "int", Defaults: []versionedDefault{{0, int64(-1)}, {1, int64(10000)}, {17, int64(800)}, {27, int64(2400)}}},
+	{Name: "IncomingMessageFilterBucketCount", Type: "int", Defaults: []versionedDefault{{0, int64(5)}}},
+	{Name: "IncomingMessageFilterBucketSize", Type: "int", Defaults: []versionedDefault{{0, int64(512)}}},
+	{Name: "LedgerSynchronousMode", Type: "int", Defaults: []versionedDefault{{12, int64(2)}}},
+	{Name: "LogArchiveDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "LogArchiveMaxAge", Type: "string", Defaults: []versionedDefault{{4, ""}}},
+	{Name: "LogArchiveName", Type: "string", Defaults: []versionedDefault{{4, "node.archive.log"}}},
+	{Name: "LogFileDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "LogSizeLimit", Type: "uint64", Defaults: []versionedDefault{{0, uint64(1073741824)}}},
+	{Name: "MaxAPIBoxPerApplication", Type: "uint64", Defaults: []versionedDefault{{25, uint64(100000)}}},
+	{Name: "MaxAPIResourcesPerAccount", Type: "uint64", Defaults: []versionedDefault{{21, uint64(100000)}}},
+	{Name: "MaxAcctLookback", Type: "uint64", Defaults: []versionedDefault{{23, uint64(4)}}},
+	{Name: "MaxBlockHistoryLookback", Type: "uint64", Defaults: []versionedDefault{{31, uint64(0)}}},
+	{Name: "MaxCatchpointDownloadDuration", Type: "time.Duration", Defaults: []versionedDefault{{13, int64(7200000000000)}, {28, int64(43200000000000)}}},
+	{Name: "MaxConnectionsPerIP", Type: "int", Defaults: []versionedDefault{{3, int64(30)}, {27, int64(15)}, {35, int64(8)}}},
+	{Name: "MinCatchpointFileDownloadBytesPerSecond", Type: "uint64", Defaults: []versionedDefault{{13, uint64(20480)}}},
+	{Name: "NetAddress", Type: "string", Defaults: []versionedDefault{{0, ""}}},
+	{Name: "NetworkMessageTraceServer", Type: "string", Defaults: []versionedDefault{{13, ""}}},
+	{Name: "NetworkProtocolVersion", Type: "string", Defaults: []versionedDefault{{6, ""}}},
+	{Name: "NodeExporterListenAddress", Type: "string", Defaults: []versionedDefault{{0, ":9100"}}},
+	{Name: "NodeExporterPath", Type: "string", Defaults: []versionedDefault{{0, "./node_exporter"}}},
+	{Name: "OptimizeAccountsDatabaseOnStartup", Type: "bool", Defaults: []versionedDefault{{10, false}}},
+	{Name: "OutgoingMessageFilterBucketCount", Type: "int", Defaults: []versionedDefault{{0, int64(3)}}},
+	{Name: "OutgoingMessageFilterBucketSize", Type: "int", Defaults: []versionedDefault{{0, int64(128)}}},
+	{Name: "P2PHybridIncomingConnectionsLimit", Type: "int", Defaults: []versionedDefault{{34, int64(1200)}}},
+	{Name: "P2PHybridNetAddress", Type: "string", Defaults: []versionedDefault{{34, ""}}},
+	{Name: "P2PPersistPeerID", Type: "bool", Defaults: []versionedDefault{{29, false}}},
+	{Name: "P2PPrivateKeyLocation", Type: "string", Defaults: []versionedDefault{{29, ""}}},
+	{Name: "ParticipationKeysRefreshInterval", Type: "time.Duration", Defaults: []versionedDefault{{16, int64(60000000000)}}},
+	{Name: "PeerConnectionsUpdateInterval", Type: "int", Defaults: []versionedDefault{{5, int64(3600)}}},
+	{Name: "PeerPingPeriodSeconds", Type: "int", Defaults: []versionedDefault{{0, int64(0)}}},
+	{Name: "PriorityPeers", Type: "map[string]bool", Defaults: []versionedDefault{{4, map[string]bool{}}}},
+	{Name: "ProposalAssemblyTime", Type: "time.Duration", Defaults: []versionedDefault{{19, int64(250000000)}, {23, int64(500000000)}}},
+	{Name: "PublicAddress", Type: "string", Defaults: []versionedDefault{{0, ""}}},
+	{Name: "ReconnectTime", Type: "time.Duration", Defaults: []versionedDefault{{0, int64(60)}, {1, int64(60000000000)}}},
+	{Name: "ReservedFDs", Type: "uint64", Defaults: []versionedDefault{{2, uint64(256)}}},
+	{Name: "RestConnectionsHardLimit", Type: "uint64", Defaults: []versionedDefault{{20, uint64(2048)}}},
+	{Name: "RestConnectionsSoftLimit", Type: "uint64", Defaults: []versionedDefault{{20, uint64(1024)}}},
+	{Name: "RestReadTimeoutSeconds", Type: "int", Defaults: []versionedDefault{{4, int64(15)}}},
+	{Name: "RestWriteTimeoutSeconds", Type: "int", Defaults: []versionedDefault{{4, int64(120)}}},
+	{Name: "RunHosted", Type: "bool", Defaults: []versionedDefault{{3, false}}},
+	{Name: "StatefulVoteCompressionTableSize", Type: "uint", Defaults: []versionedDefault{{37, uint64(2048)}}},
+	{Name: "StateproofDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "StorageEngine", Type: "string", Defaults: []versionedDefault{{28, "sqlite"}}},
+	{Name: "SuggestedFeeBlockHistory", Type: "int", Defaults: []versionedDefault{{0, int64(3)}}},
+	{Name: "SuggestedFeeSlidingWindowSize", Type: "uint32", Defaults: []versionedDefault{{3, uint64(50)}}},
+	{Name: "TLSCertFile", Type: "string", Defaults: []versionedDefault{{0, ""}}},
+	{Name: "TLSKeyFile", Type: "string", Defaults: []versionedDefault{{0, ""}}},
+	{Name: "TelemetryToLog", Type: "bool", Defaults: []versionedDefault{{5, true}}},
+	{Name: "TrackerDBDir", Type: "string", Defaults: []versionedDefault{{31, ""}}},
+	{Name: "TransactionSyncDataExchangeRate", Type: "uint64", Defaults: []versionedDefault{{17, uint64(0)}}},
+	{Name: "TransactionSyncSignificantMessageThreshold", Type: "uint64", Defaults: []versionedDefault{{17, uint64(0)}}},
+	{Name: "TxBacklogAppRateLimitingCongestionPct", Type: "int", Defaults: []versionedDefault{{38, int64(10)}}},
+	{Name: "TxBacklogAppRateLimitingCountERLDrops", Type: "bool", Defaults: []versionedDefault{{35, false}}},
+	{Name: "TxBacklogAppTxPerSecondRate", Type: "int", Defaults: []versionedDefault{{32, int64(100)}}},
+	{Name: "TxBacklogAppTxRateLimiterMaxSize", Type: "int", Defaults: []versionedDefault{{32, int64(1048576)}}},
+	{Name: "TxBacklogRateLimitingCongestionPct", Type: "int", Defaults: []versionedDefault{{32, int64(50)}}},
+	{Name: "TxBacklogReservedCapacityPerPeer", Type: "int", Defaults: []versionedDefault{{27, int64(20)}}},
+	{Name: "TxBacklogServiceRateWindowSeconds", Type: "int", Defaults: []versionedDefault{{27, int64(10)}}},
+	{Name: "TxBacklogSize", Type: "int", Defaults: []versionedDefault{{27, int64(26000)}}},
+	{Name: "TxIncomingFilterMaxSize", Type: "uint64", Defaults: []versionedDefault{{28, uint64(500000)}}},
+	{Name: "TxIncomingFilteringFlags", Type: "uint32", Defaults: []versionedDefault{{26, uint64(1)}}},
+	{Name: "TxPoolExponentialIncreaseFactor", Type: "uint64", Defaults: []versionedDefault{{0, uint64(2)}}},
+	{Name: "TxPoolSize", Type: "int", Defaults: []versionedDefault{{0, int64(50000)}, {5, int64(15000)}, {23, int64(75000)}}},
+	{Name: "TxSyncIntervalSeconds", Type: "int64", Defaults: []versionedDefault{{0, int64(60)}}},
+	{Name: "TxSyncServeResponseSize", Type: "int", Defaults: []versionedDefault{{3, int64(1000000)}}},
+	{Name: "TxSyncTimeoutSeconds", Type: "int64", Defaults: []versionedDefault{{0, int64(30)}}},
+	{Name: "UseXForwardedForAddressField", Type: "string", Defaults: []versionedDefault{{0, ""}}},
+	{Name: "VerifiedTranscationsCacheSize", Type: "int", Defaults: []versionedDefault{{14, int64(30000)}, {23, int64(150000)}}},
+}
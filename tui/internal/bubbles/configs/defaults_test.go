@@ -0,0 +1,92 @@
+package configs
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDefaultFor(t *testing.T) {
+	tests := []struct {
+		setting string
+		version int
+		want    interface{}
+		found   bool
+	}{
+		{setting: "TxPoolSize", version: 0, want: int64(50000), found: true},
+		{setting: "TxPoolSize", version: 4, want: int64(50000), found: true},
+		{setting: "TxPoolSize", version: 5, want: int64(15000), found: true},
+		{setting: "TxPoolSize", version: latestVersion, want: int64(75000), found: true},
+		{setting: "DNSBootstrapID", version: 27, want: "<network>.algorand.network", found: true},
+		{setting: "DNSBootstrapID", version: 28, want: "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)", found: true},
+		{setting: "ColdDataDir", version: 30},
+		{setting: "ColdDataDir", version: 31, want: "", found: true},
+	}
+	for _, tt := range tests {
+		s, ok := findSetting(tt.setting)
+		if !ok {
+			t.Fatalf("unknown setting %s", tt.setting)
+		}
+		got, found := s.defaultFor(tt.version)
+		if found != tt.found || got != tt.want {
+			t.Errorf("%s.defaultFor(%d) = %#v, %v, want %#v, %v", tt.setting, tt.version, got, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestBuildRows(t *testing.T) {
+	decoder := json.NewDecoder(strings.NewReader(`{
+		"Version": 30,
+		"GossipFanout": 4,
+		"TxPoolSize": 20000,
+		"DNSBootstrapID": "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
+		"ColdDataDir": "/mnt/cold",
+		"SomeRemovedSetting": true
+	}`))
+	decoder.UseNumber()
+	var values map[string]interface{}
+	if err := decoder.Decode(&values); err != nil {
+		t.Fatal(err)
+	}
+	version := configVersion(values)
+	if version != 30 {
+		t.Fatalf("configVersion() = %d, want 30", version)
+	}
+
+	rows := make(map[string]row)
+	for _, r := range buildRows(values, version) {
+		rows[r.Key] = r
+	}
+	tests := []struct {
+		key  string
+		want row
+	}{
+		{key: "GossipFanout", want: row{Value: "4", Default: "4", Known: true}},
+		{key: "TxPoolSize", want: row{Value: "20000", Default: "75000", Known: true, Overridden: true}},
+		{key: "Archival", want: row{Value: "false", Default: "false", Known: true}},
+		{key: "DNSBootstrapID", want: row{
+			Value:   `"<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)"`,
+			Default: `"<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)"`,
+			Known:   true,
+		}},
+		// ColdDataDir was added in version 31.
+		{key: "ColdDataDir", want: row{Value: `"/mnt/cold"`, Default: "-", Overridden: true}},
+		{key: "SomeRemovedSetting", want: row{Value: "true", Default: "-", Overridden: true}},
+	}
+	for _, tt := range tests {
+		got, ok := rows[tt.key]
+		if !ok {
+			t.Errorf("no row for %s", tt.key)
+			continue
+		}
+		got.Key, got.Description = "", ""
+		if got != tt.want {
+			t.Errorf("%s = %+v, want %+v", tt.key, got, tt.want)
+		}
+	}
+	for _, key := range []string{"Version", "BlockDBDir"} {
+		if _, ok := rows[key]; ok {
+			t.Errorf("unexpected row for %s", key)
+		}
+	}
+}
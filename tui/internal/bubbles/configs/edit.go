@@ -0,0 +1,178 @@
+package configs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
+)
+
+var (
+	// errReadOnly is shown when editing without the write flag.
+	errReadOnly = errors.New("editing is disabled, start with --allow-config-write to edit config.json")
+	// errViewer is shown when a viewer tries to edit.
+	errViewer = errors.New("editing config.json requires the operator role")
+	// errRemote is shown when editing settings read from the API.
+	errRemote = errors.New("settings from the API are read only, start with -d to edit config.json")
+)
+
+// findSetting returns the schema of a known setting.
+func findSetting(name string) (setting, bool) {
+	for _, s := range settings {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return setting{}, false
+}
+
+// parseValue validates input against the type of the setting, numbers must
+// fit the range of the type.
+func parseValue(s setting, input string) (interface{}, error) {
+	input = strings.TrimSpace(input)
+	if n, ok := integers[s.Type]; ok {
+		if n.signed {
+			value, err := strconv.ParseInt(input, 10, n.bits)
+			if err != nil {
+				return nil, fmt.Errorf("expected a whole number from %d to %d", int64(-1)<<(n.bits-1), uint64(1)<<(n.bits-1)-1)
+			}
+			return value, nil
+		}
+		value, err := strconv.ParseUint(input, 10, n.bits)
+		if err != nil {
+			return nil, fmt.Errorf("expected a whole number from 0 to %d", uint64(1<<n.bits-1))
+		}
+		return value, nil
+	}
+
+	switch s.Type {
+	case "bool":
+		value, err := strconv.ParseBool(input)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false")
+		}
+		return value, nil
+	case "string":
+		return input, nil
+	case "map[string]bool":
+		var value map[string]bool
+		if err := json.Unmarshal([]byte(input), &value); err != nil {
+			return nil, fmt.Errorf(`expected a JSON object such as {"host:port": true}`)
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("editing %s settings is not supported", s.Type)
+	}
+}
+
+// startEdit toggles the selected bool setting, or opens the input for other
+// settings.
+func (m *Model) startEdit() tea.Cmd {
+	if m.selected >= len(m.visible) {
+		return nil
+	}
+	if m.viewer {
+		m.saveErr = errViewer
+		return nil
+	}
+	if !m.writable {
+		m.saveErr = errReadOnly
+		return nil
+	}
+	if m.source != messages.SourceDisk {
+		m.saveErr = errRemote
+		return nil
+	}
+	selected := m.visible[m.selected]
+	s, ok := findSetting(selected.Key)
+	def, known := s.defaultFor(m.version)
+	if !ok || !known {
+		m.saveErr = fmt.Errorf("%s is not a known setting for config version %d", selected.Key, m.version)
+		return nil
+	}
+
+	if _, ok := def.(bool); ok {
+		return m.confirmSave(selected.Key, selected.Value != "true")
+	}
+
+	m.editing = true
+	m.editKey = selected.Key
+	m.editErr = nil
+	m.input.Prompt = selected.Key + ": "
+	m.input.Reset()
+	current := selected.Value
+	if _, ok := def.(string); ok {
+		// Edit the string without its JSON quotes.
+		var unquoted string
+		if err := json.Unmarshal([]byte(current), &unquoted); err == nil {
+			current = unquoted
+		}
+	}
+	m.input.SetValue(current)
+	m.input.CursorEnd()
+	return m.input.Focus()
+}
+
+// confirmSave asks for a confirmation before writing a setting.
+func (m *Model) confirmSave(name string, value interface{}) tea.Cmd {
+	current := "-"
+	for _, r := range m.rows {
+		if r.Key == name {
+			current = r.Value
+		}
+	}
+	return confirm.Ask(confirm.Request{
+		Title:       "Edit config.json",
+		Description: "The previous config.json is backed up. algod reads config.json when it starts, restart it to apply the change.",
+		Details: []confirm.Detail{
+			{Label: "File", Value: m.path},
+			{Label: "Setting", Value: name},
+			{Label: "Current", Value: current},
+			{Label: "New", Value: formatValue(value)},
+		},
+		Confirm: m.saveCmd(name, value),
+	})
+}
+
+// saveCmd writes a single setting to config.json.
+func (m *Model) saveCmd(name string, value interface{}) tea.Cmd {
+	updates := map[string]interface{}{name: value}
+	// A new config.json needs the version the defaults were taken from.
+	if errors.Is(m.err, fs.ErrNotExist) {
+		updates["Version"] = m.version
+	}
+	m.saveErr = nil
+	m.saved = ""
+	return m.requestor.SetNodeConfigCmd(updates)
+}
+
+// updateEdit handles keys while a setting is being typed.
+func (m Model) updateEdit(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.editing = false
+		m.input.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		s, _ := findSetting(m.editKey)
+		value, err := parseValue(s, m.input.Value())
+		if err != nil {
+			m.editErr = err
+			return m, nil
+		}
+		m.editing = false
+		m.input.Blur()
+		return m, m.confirmSave(m.editKey, value)
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
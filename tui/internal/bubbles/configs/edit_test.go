@@ -0,0 +1,54 @@
+package configs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		setting string
+		input   string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "bool", setting: "Archival", input: "true", want: true},
+		{name: "bool invalid", setting: "Archival", input: "yes", wantErr: true},
+		{name: "string", setting: "NetAddress", input: " :4160 ", want: ":4160"},
+		{name: "int negative", setting: "GossipFanout", input: "-1", want: int64(-1)},
+		{name: "int not a number", setting: "GossipFanout", input: "4.5", wantErr: true},
+		{name: "duration", setting: "ParticipationKeysRefreshInterval", input: "60000000000", want: int64(60000000000)},
+		{name: "uint64", setting: "CatchupParallelBlocks", input: "32", want: uint64(32)},
+		{name: "uint64 negative", setting: "CatchupParallelBlocks", input: "-1", wantErr: true},
+		{name: "uint64 max", setting: "CatchupParallelBlocks", input: "18446744073709551615", want: uint64(18446744073709551615)},
+		{name: "uint64 too large", setting: "CatchupParallelBlocks", input: "18446744073709551616", wantErr: true},
+		{name: "uint32", setting: "BaseLoggerDebugLevel", input: "5", want: uint64(5)},
+		{name: "uint32 negative", setting: "BaseLoggerDebugLevel", input: "-4", wantErr: true},
+		{name: "uint32 max", setting: "BaseLoggerDebugLevel", input: "4294967295", want: uint64(4294967295)},
+		{name: "uint32 too large", setting: "BaseLoggerDebugLevel", input: "4294967296", wantErr: true},
+		{name: "map", setting: "PriorityPeers", input: `{"relay:4160": true}`, want: map[string]bool{"relay:4160": true}},
+		{name: "map invalid", setting: "PriorityPeers", input: "relay:4160", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ok := findSetting(tt.setting)
+			if !ok {
+				t.Fatalf("unknown setting %s", tt.setting)
+			}
+			got, err := parseValue(s, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseValue(%s, %q) error = %v, wantErr %v", tt.setting, tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseValue(%s, %q) = %#v, want %#v", tt.setting, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseValueUnsupportedType(t *testing.T) {
+	if _, err := parseValue(setting{Name: "Peers", Type: "[]string"}, "a"); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
@@ -0,0 +1,206 @@
+package configs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+
+	sdkjson "github.com/algorand/go-algorand-sdk/v2/encoding/json"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/constants"
+	"github.com/algorand/node-ui/tui/internal/layout"
+)
+
+// view is the page shown in the tab.
+type view int
+
+const (
+	settingsView view = iota
+	filesView
+	fileView
+)
+
+// topAllocations is how many genesis accounts are listed in the summary.
+const topAllocations = 10
+
+// renderedFileMsg has a data directory file rendered for the viewer.
+type renderedFileMsg struct {
+	file    messages.DataFile
+	content string
+	err     error
+}
+
+// openFileCmd reads and renders a data directory file.
+func (m Model) openFileCmd(name string, width int) tea.Cmd {
+	read := m.requestor.GetDataFileCmd(name)
+	return func() tea.Msg {
+		msg := read().(messages.DataFileMsg)
+		if msg.Err != nil {
+			return renderedFileMsg{file: msg.File, err: msg.Err}
+		}
+		content, err := renderFile(msg.File.Name, msg.Content, width)
+		return renderedFileMsg{file: msg.File, content: content, err: err}
+	}
+}
+
+// renderFile pretty prints a file with syntax highlighting. The genesis file
+// starts with a summary of the network and its allocations.
+func renderFile(name string, content []byte, width int) (string, error) {
+	var markdown strings.Builder
+	if filepath.Base(name) == "genesis.json" {
+		var genesis types.Genesis
+		if err := sdkjson.LenientDecode(content, &genesis); err == nil {
+			markdown.WriteString(genesisSummary(genesis))
+		}
+	}
+
+	language := "json"
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, content, "", "  "); err != nil {
+		// Not JSON, show it as is.
+		language = ""
+		pretty.Reset()
+		pretty.Write(content)
+	}
+	fmt.Fprintf(&markdown, "```%s\n%s\n```\n", language, strings.TrimRight(pretty.String(), "\n"))
+
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle("dark"),
+		glamour.WithWordWrap(layout.Max(40, width-4)),
+	)
+	if err != nil {
+		return "", err
+	}
+	return r.Render(markdown.String())
+}
+
+// genesisSummary describes the network parameters and largest allocations.
+func genesisSummary(genesis types.Genesis) string {
+	var total uint64
+	online := 0
+	for _, alloc := range genesis.Allocation {
+		total += alloc.State.MicroAlgos
+		if alloc.State.Status == 1 {
+			online++
+		}
+	}
+
+	var b strings.Builder
+	// Code spans keep glamour from turning the protocol URL into a link,
+	// which is dropped from table cells.
+	b.WriteString("# Genesis\n\n")
+	b.WriteString("| | |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Genesis ID | %s |\n", genesis.ID())
+	fmt.Fprintf(&b, "| Protocol | `%s` |\n", genesis.Proto)
+	if genesis.Timestamp != 0 {
+		fmt.Fprintf(&b, "| Created | %s |\n", time.Unix(genesis.Timestamp, 0).UTC().Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "| Dev mode | %t |\n", genesis.DevMode)
+	fmt.Fprintf(&b, "| Fee sink | `%s` |\n", genesis.FeeSink)
+	fmt.Fprintf(&b, "| Rewards pool | `%s` |\n", genesis.RewardsPool)
+	fmt.Fprintf(&b, "| Accounts | %d, %d online |\n", len(genesis.Allocation), online)
+	fmt.Fprintf(&b, "| Total | %s Algos |\n", algosString(total))
+	if genesis.Comment != "" {
+		fmt.Fprintf(&b, "| Comment | %s |\n", genesis.Comment)
+	}
+
+	allocs := append([]types.GenesisAllocation{}, genesis.Allocation...)
+	sort.SliceStable(allocs, func(i, j int) bool { return allocs[i].State.MicroAlgos > allocs[j].State.MicroAlgos })
+	if len(allocs) > topAllocations {
+		allocs = allocs[:topAllocations]
+	}
+	b.WriteString("\n## Largest allocations\n\n")
+	b.WriteString("| Comment | Address | Algos | Online |\n|---|---|---:|---|\n")
+	for _, alloc := range allocs {
+		fmt.Fprintf(&b, "| %s | %s | %s | %t |\n",
+			alloc.Comment, shorten(alloc.Address, 12), algosString(alloc.State.MicroAlgos), alloc.State.Status == 1)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// algosString renders micro Algos as Algos without trailing zeros.
+func algosString(microAlgos uint64) string {
+	algos := fmt.Sprintf("%d.%06d", microAlgos/1000000, microAlgos%1000000)
+	return strings.TrimSuffix(strings.TrimRight(algos, "0"), ".")
+}
+
+func (m Model) filesString() string {
+	if m.filesErr != nil {
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to list data directory files: %s", m.filesErr))
+	}
+	if len(m.files) == 0 {
+		return "No recognised files in the data directory."
+	}
+
+	builder := strings.Builder{}
+	for i, f := range m.files {
+		cursor := " "
+		if i == m.fileSelected {
+			cursor = ">"
+		}
+		modified := "-"
+		if !f.ModTime.IsZero() {
+			modified = f.ModTime.Format("2006-01-02 15:04")
+		}
+		builder.WriteString(fmt.Sprintf("%s %-28s %10d  %-16s  %s\n",
+			m.style.BottomListItemSelector.Copy().MarginLeft(0).Render(cursor),
+			f.Name,
+			f.Size,
+			modified,
+			m.style.AccountGrayText.Render(f.Description)))
+	}
+	return builder.String()
+}
+
+// updateFileKeys handles the file list and viewer key bindings.
+func (m Model) updateFileKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.view == fileView {
+		if key.Matches(msg, constants.Keys.Back) {
+			m.view = filesView
+			m.viewport.GotoTop()
+			m.setContent()
+			return m, nil
+		}
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, constants.Keys.Back), key.Matches(msg, constants.Keys.DataFiles):
+		m.view = settingsView
+		m.viewport.GotoTop()
+		m.setContent()
+		return m, nil
+	case key.Matches(msg, constants.Keys.Forward):
+		if m.fileSelected < len(m.files) {
+			m.fileErr = nil
+			return m, m.openFileCmd(m.files[m.fileSelected].Name, m.viewport.Width)
+		}
+		return m, nil
+	case key.Matches(msg, constants.Keys.Up):
+		if m.fileSelected > 0 {
+			m.fileSelected--
+		}
+	case key.Matches(msg, constants.Keys.Down):
+		if m.fileSelected < len(m.files)-1 {
+			m.fileSelected++
+		}
+	default:
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+	m.setContent()
+	return m, nil
+}
@@ -0,0 +1,177 @@
+// Command defaultsgen generates the config.json defaults table from the
+// version tags of the Local struct in go-algorand's config/localTemplate.go.
+//
+//	go run ./internal/defaultsgen -template ../go-algorand/config/localTemplate.go -out defaults_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// versionTag matches one version[N]:"value" entry of a struct tag.
+var versionTag = regexp.MustCompile(`version\[(\d+)\]:"((?:[^"\\]|\\.)*)"`)
+
+// versioned is a default value starting with a config version.
+type versioned struct {
+	version int
+	value   string
+}
+
+// field is a setting of the Local struct.
+type field struct {
+	name     string
+	goType   string
+	defaults []versioned
+}
+
+func main() {
+	template := flag.String("template", "", "Path to go-algorand config/localTemplate.go.")
+	out := flag.String("out", "defaults_gen.go", "File to write the defaults table to.")
+	flag.Parse()
+
+	if *template == "" {
+		fmt.Fprintln(os.Stderr, "defaultsgen: -template is required")
+		os.Exit(2)
+	}
+	if err := run(*template, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "defaultsgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(template, out string) error {
+	fields, err := parseLocal(template)
+	if err != nil {
+		return err
+	}
+
+	latest := 0
+	var settings []field
+	for _, f := range fields {
+		if f.name == "Version" {
+			latest = f.defaults[len(f.defaults)-1].version
+			continue
+		}
+		settings = append(settings, f)
+	}
+	if latest == 0 {
+		return fmt.Errorf("no Version field in %s", template)
+	}
+	sort.Slice(settings, func(i, j int) bool { return settings[i].name < settings[j].name })
+
+	src, err := render(settings, latest)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, src, 0644)
+}
+
+// parseLocal reads the versioned fields of the Local struct.
+func parseLocal(path string) ([]field, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var local *ast.StructType
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok && spec.Name.Name == "Local" {
+			local, _ = spec.Type.(*ast.StructType)
+			return false
+		}
+		return local == nil
+	})
+	if local == nil {
+		return nil, fmt.Errorf("no Local struct in %s", path)
+	}
+
+	var fields []field
+	for _, f := range local.Fields.List {
+		if f.Tag == nil || len(f.Names) != 1 || !f.Names[0].IsExported() {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Names[0].Name, err)
+		}
+		var defaults []versioned
+		for _, match := range versionTag.FindAllStringSubmatch(tag, -1) {
+			version, _ := strconv.Atoi(match[1])
+			defaults = append(defaults, versioned{version: version, value: match[2]})
+		}
+		if len(defaults) == 0 {
+			continue
+		}
+		sort.SliceStable(defaults, func(i, j int) bool { return defaults[i].version < defaults[j].version })
+		fields = append(fields, field{
+			name:     f.Names[0].Name,
+			goType:   types.ExprString(f.Type),
+			defaults: defaults,
+		})
+	}
+	return fields, nil
+}
+
+// literal renders a tag value as a Go expression of the table value type. An
+// empty value is the zero value of the type.
+func literal(goType, value string) (string, error) {
+	if value == "" && goType == "bool" {
+		value = "false"
+	} else if value == "" && goType != "string" {
+		value = "0"
+	}
+	switch goType {
+	case "bool":
+		b, err := strconv.ParseBool(value)
+		return strconv.FormatBool(b), err
+	case "int", "int64", "time.Duration":
+		n, err := strconv.ParseInt(value, 10, 64)
+		return fmt.Sprintf("int64(%d)", n), err
+	case "uint", "uint32", "uint64":
+		n, err := strconv.ParseUint(value, 10, 64)
+		return fmt.Sprintf("uint64(%d)", n), err
+	case "string":
+		return strconv.Quote(value), nil
+	case "map[string]bool":
+		return "map[string]bool{}", nil
+	}
+	return "", fmt.Errorf("unsupported type %s", goType)
+}
+
+func render(settings []field, latest int) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by defaultsgen from go-algorand config/localTemplate.go. DO NOT EDIT.\n\n")
+	b.WriteString("package configs\n\n")
+	b.WriteString("// latestVersion is the newest config version in the defaults table. It is\n")
+	b.WriteString("// used when config.json does not have a Version.\n")
+	fmt.Fprintf(&b, "const latestVersion = %d\n\n", latest)
+	b.WriteString("// settings are the algod config.json defaults, from the version tags of the\n")
+	b.WriteString("// go-algorand Local config struct.\n")
+	b.WriteString("var settings = []setting{\n")
+	for _, s := range settings {
+		defaults := make([]string, 0, len(s.defaults))
+		for _, d := range s.defaults {
+			value, err := literal(s.goType, d.value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", s.name, err)
+			}
+			defaults = append(defaults, fmt.Sprintf("{%d, %s}", d.version, value))
+		}
+		fmt.Fprintf(&b, "\t{Name: %q, Type: %q, Defaults: []versionedDefault{%s}},\n",
+			s.name, s.goType, strings.Join(defaults, ", "))
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
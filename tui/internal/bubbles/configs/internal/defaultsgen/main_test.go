@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const localTemplate = `package config
+
+type Local struct {
+	Version uint32 ` + "`" + `version[0]:"0" version[1]:"1" version[2]:"2"` + "`" + `
+
+	// not versioned
+	Internal string
+
+	TxPoolSize int ` + "`" + `version[0]:"50000" version[5]:"15000" version[23]:"75000"` + "`" + `
+	EnableAssembleStats bool ` + "`" + `version[0]:""` + "`" + `
+	DNSBootstrapID string ` + "`" + `version[0]:"<network>.algorand.network" version[28]:"a\"b"` + "`" + `
+	CatchupParallelBlocks uint64 ` + "`" + `version[3]:"16" version[5]:"50"` + "`" + `
+	PriorityPeers map[string]bool ` + "`" + `version[4]:""` + "`" + `
+}
+`
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "localTemplate.go")
+	out := filepath.Join(dir, "defaults_gen.go")
+	if err := os.WriteFile(in, []byte(localTemplate), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(in, out); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := string(data)
+	for _, want := range []string{
+		"const latestVersion = 2\n",
+		`{Name: "CatchupParallelBlocks", Type: "uint64", Defaults: []versionedDefault{{3, uint64(16)}, {5, uint64(50)}}},`,
+		`{Name: "DNSBootstrapID", Type: "string", Defaults: []versionedDefault{{0, "<network>.algorand.network"}, {28, "a\\\"b"}}},`,
+		`{Name: "EnableAssembleStats", Type: "bool", Defaults: []versionedDefault{{0, false}}},`,
+		`{Name: "PriorityPeers", Type: "map[string]bool", Defaults: []versionedDefault{{4, map[string]bool{}}}},`,
+		`{Name: "TxPoolSize", Type: "int", Defaults: []versionedDefault{{0, int64(50000)}, {5, int64(15000)}, {23, int64(75000)}}},`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated file is missing %s:\n%s", want, src)
+		}
+	}
+	if strings.Contains(src, "Internal") || strings.Contains(src, `Name: "Version"`) {
+		t.Errorf("generated file has settings which are not versioned:\n%s", src)
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	tests := []struct {
+		goType  string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{goType: "bool", value: "true", want: "true"},
+		{goType: "bool", value: "", want: "false"},
+		{goType: "int", value: "-1", want: "int64(-1)"},
+		{goType: "time.Duration", value: "", want: "int64(0)"},
+		{goType: "uint64", value: "18446744073709551615", want: "uint64(18446744073709551615)"},
+		{goType: "uint32", value: "-1", wantErr: true},
+		{goType: "string", value: "", want: `""`},
+		{goType: "[]string", value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := literal(tt.goType, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("literal(%s, %q) error = %v, wantErr %v", tt.goType, tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("literal(%s, %q) = %s, want %s", tt.goType, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package confirm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+// maxWidth is the widest the dialog is drawn.
+const maxWidth = 72
+
+// Detail is a labelled value shown in the dialog, such as the target network.
+type Detail struct {
+	Label string
+	Value string
+}
+
+// Request describes an action to confirm. A request without a Confirm
+// command only shows a message.
+type Request struct {
+	Title       string
+	Description string
+	Details     []Detail
+	// Typed must be typed out to confirm, otherwise y confirms.
+	Typed string
+	// Confirm runs when the action is confirmed.
+	Confirm tea.Cmd
+}
+
+// RequestMsg opens the dialog, bubbles ask for a confirmation with Ask.
+type RequestMsg Request
+
+// Ask returns a command which opens the dialog for the request.
+func Ask(request Request) tea.Cmd {
+	return func() tea.Msg {
+		return RequestMsg(request)
+	}
+}
+
+// Model representing the confirmation dialog.
+type Model struct {
+	style   *style.Styles
+	request Request
+	active  bool
+	input   textinput.Model
+	// mismatch is set when the typed confirmation was wrong.
+	mismatch bool
+}
+
+// New creates the confirmation dialog Model.
+func New(style *style.Styles) Model {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.CharLimit = 64
+	return Model{
+		style: style,
+		input: input,
+	}
+}
+
+// Active is true while the dialog is shown, it receives every key press.
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Open shows the dialog for the request.
+func (m Model) Open(request Request) (Model, tea.Cmd) {
+	m.request = request
+	m.active = true
+	m.mismatch = false
+	m.input.Reset()
+	if request.Confirm != nil && request.Typed != "" {
+		m.input.Placeholder = request.Typed
+		return m, m.input.Focus()
+	}
+	m.input.Blur()
+	return m, nil
+}
+
+// confirm closes the dialog and runs the action.
+func (m Model) confirm() (Model, tea.Cmd) {
+	m.active = false
+	m.input.Blur()
+	return m, m.request.Confirm
+}
+
+// cancel closes the dialog without running the action.
+func (m Model) cancel() (Model, tea.Cmd) {
+	m.active = false
+	m.input.Blur()
+	return m, nil
+}
+
+// Update handles the key presses while the dialog is shown.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !m.active || !ok {
+		return m, nil
+	}
+
+	switch {
+	case m.request.Confirm == nil:
+		// A message is closed by any key.
+		return m.cancel()
+	case keyMsg.Type == tea.KeyEsc:
+		return m.cancel()
+	case m.request.Typed != "":
+		if keyMsg.Type == tea.KeyEnter {
+			if strings.TrimSpace(m.input.Value()) == m.request.Typed {
+				return m.confirm()
+			}
+			m.mismatch = true
+			m.input.Reset()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "y", "Y":
+		return m.confirm()
+	case "n", "N":
+		return m.cancel()
+	}
+	return m, nil
+}
+
+// promptView tells how to confirm or close the dialog.
+func (m Model) promptView() string {
+	switch {
+	case m.request.Confirm == nil:
+		return m.style.AccountHelpText.Render("Press any key to close.")
+	case m.request.Typed != "":
+		prompt := fmt.Sprintf("Type %s to confirm, esc to cancel.", m.style.AccountBoldText.Render(m.request.Typed))
+		if m.mismatch {
+			prompt += "\n" + m.style.StatusCriticalText.Render("That does not match, try again.")
+		}
+		return prompt + "\n" + m.input.View()
+	default:
+		return fmt.Sprintf("Confirm? (%s/N)", m.style.AccountBoldText.Render("y"))
+	}
+}
+
+// View renders the dialog centered in an area of the given size.
+func (m Model) View(width, height int) string {
+	boxWidth := maxWidth
+	if width-4 < boxWidth {
+		boxWidth = width - 4
+	}
+	border := m.style.WarningBorderColor
+	if m.request.Typed != "" {
+		border = m.style.CriticalBorderColor
+	}
+	box := lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(border).
+		Padding(0, 1).
+		Width(boxWidth)
+
+	var b strings.Builder
+	b.WriteString(m.style.StatusBoldText.Render(m.request.Title))
+	b.WriteString("\n\n")
+	if m.request.Description != "" {
+		b.WriteString(m.request.Description)
+		b.WriteString("\n\n")
+	}
+	labelWidth := 0
+	for _, d := range m.request.Details {
+		if len(d.Label) > labelWidth {
+			labelWidth = len(d.Label)
+		}
+	}
+	for _, d := range m.request.Details {
+		b.WriteString(fmt.Sprintf("%-*s  %s\n", labelWidth+1, d.Label+":", d.Value))
+	}
+	if len(m.request.Details) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString(m.promptView())
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box.Render(b.String()))
+}
@@ -0,0 +1,92 @@
+package confirm
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+type confirmedMsg struct{}
+
+func confirmed() tea.Msg { return confirmedMsg{} }
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   Request
+		keys      []tea.KeyMsg
+		confirmed bool
+		active    bool
+	}{
+		{name: "yes", request: Request{Confirm: confirmed}, keys: []tea.KeyMsg{runes("y")}, confirmed: true},
+		{name: "no", request: Request{Confirm: confirmed}, keys: []tea.KeyMsg{runes("n")}},
+		{name: "esc", request: Request{Confirm: confirmed}, keys: []tea.KeyMsg{{Type: tea.KeyEsc}}},
+		{name: "other key", request: Request{Confirm: confirmed}, keys: []tea.KeyMsg{runes("x")}, active: true},
+		{name: "enter does not confirm", request: Request{Confirm: confirmed}, keys: []tea.KeyMsg{{Type: tea.KeyEnter}}, active: true},
+		{
+			name:      "typed",
+			request:   Request{Confirm: confirmed, Typed: "mainnet-v1.0"},
+			keys:      []tea.KeyMsg{runes("mainnet-v1.0"), {Type: tea.KeyEnter}},
+			confirmed: true,
+		},
+		{
+			name:    "typed y is not enough",
+			request: Request{Confirm: confirmed, Typed: "mainnet-v1.0"},
+			keys:    []tea.KeyMsg{runes("y"), {Type: tea.KeyEnter}},
+			active:  true,
+		},
+		{
+			name:    "typed mismatch then esc",
+			request: Request{Confirm: confirmed, Typed: "mainnet-v1.0"},
+			keys:    []tea.KeyMsg{runes("mainnet"), {Type: tea.KeyEnter}, {Type: tea.KeyEsc}},
+		},
+		{name: "message closes on any key", request: Request{Title: "Error"}, keys: []tea.KeyMsg{runes("y")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := New(style.DefaultStyles()).Open(tt.request)
+			var got bool
+			for _, key := range tt.keys {
+				var cmd tea.Cmd
+				m, cmd = m.Update(key)
+				if cmd != nil {
+					if _, ok := cmd().(confirmedMsg); ok {
+						got = true
+					}
+				}
+			}
+			if got != tt.confirmed {
+				t.Errorf("confirmed = %v, want %v", got, tt.confirmed)
+			}
+			if m.Active() != tt.active {
+				t.Errorf("active = %v, want %v", m.Active(), tt.active)
+			}
+		})
+	}
+}
+
+func TestTypedMismatchResetsInput(t *testing.T) {
+	m, _ := New(style.DefaultStyles()).Open(Request{Confirm: confirmed, Typed: "mainnet-v1.0"})
+	m, _ = m.Update(runes("testnet-v1.0"))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.mismatch || m.input.Value() != "" {
+		t.Fatalf("mismatch = %v, input = %q, want a reset input", m.mismatch, m.input.Value())
+	}
+	m, _ = m.Update(runes("mainnet-v1.0"))
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || m.Active() {
+		t.Error("the second attempt did not confirm")
+	}
+
+	// Opening a new request clears the previous attempt.
+	m, _ = m.Open(Request{Confirm: confirmed, Typed: "mainnet-v1.0"})
+	if m.mismatch || m.input.Value() != "" {
+		t.Error("the dialog kept the previous attempt")
+	}
+}
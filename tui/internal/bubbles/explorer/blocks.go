@@ -10,12 +10,17 @@ import (
 	table "github.com/calyptia/go-bubble-table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algorand/node-ui/config"
 )
 
 // BlockItem is used by the list bubble.
 type BlockItem struct {
 	Round uint64
 	Block models.BlockResponse
+
+	// book labels the proposer when the block is rendered.
+	book *config.AddressBook
 }
 
 // Hacked these in to workaround missing style options in table model
@@ -25,9 +30,17 @@ var keyStyle = inactiveStyle.Copy().Width(10).Foreground(lipgloss.Color("#A3A322
 
 var blockTableHeader = []string{"  ROUND", "Txns", "Pay", "[Sum λ]", "Axfer", "Acfg", "Afrz", "[Unique]", "Appl", "[Unique]", "Proposer"}
 
-func proposer(cert *map[string]interface{}) string {
+// Proposer decodes the block proposer address from a block certificate.
+func Proposer(cert *map[string]interface{}) string {
+	if addr, ok := proposerAddress(cert); ok {
+		return addr.String()
+	}
+	return "<unknown>"
+}
+
+func proposerAddress(cert *map[string]interface{}) (types.Address, bool) {
 	if cert == nil {
-		return "<unknown>"
+		return types.Address{}, false
 	}
 
 	// There must be a better way than this...
@@ -43,7 +56,7 @@ func proposer(cert *map[string]interface{}) string {
 						case []byte:
 							var addr types.Address
 							copy(addr[:], c2)
-							return addr.String()
+							return addr, true
 						}
 					}
 				}
@@ -51,7 +64,17 @@ func proposer(cert *map[string]interface{}) string {
 		}
 	}
 
-	return "<unknown>"
+	return types.Address{}, false
+}
+
+// labelProposer renders the proposer with its address book label.
+func labelProposer(b BlockItem) string {
+	if addr, ok := proposerAddress(b.Block.Cert); ok {
+		if label, ok := b.book.Label(addr); ok {
+			return label
+		}
+	}
+	return Proposer(b.Block.Cert)
 }
 
 func computeBlockRow(b BlockItem) string {
@@ -113,7 +136,7 @@ func computeBlockRow(b BlockItem) string {
 		len(assets),
 		typeCount[types.ApplicationCallTx],
 		len(apps),
-		proposer(b.Block.Cert))
+		labelProposer(b))
 }
 
 // Render implements the Row interface to display a row of data.
@@ -144,6 +167,7 @@ func (m *Model) updateBlockTable() {
 
 	var rows []table.Row
 	for _, b := range m.blocks {
+		b.book = m.book
 		rows = append(rows, b)
 	}
 
@@ -3,6 +3,7 @@ package explorer
 import (
 	"bytes"
 	"context"
+	"fmt"
 	table "github.com/calyptia/go-bubble-table"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -11,7 +12,9 @@ import (
 
 	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
 
+	"github.com/algorand/node-ui/config"
 	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
 	"github.com/algorand/node-ui/tui/internal/constants"
 	"github.com/algorand/node-ui/tui/internal/style"
 )
@@ -48,10 +51,12 @@ type Model struct {
 	table     table.Model
 	txnView   viewport.Model
 	requestor *messages.Requestor
+	// book is optional, it labels senders and proposers.
+	book *config.AddressBook
 }
 
-// New constructs the explorer Model.
-func New(styles *style.Styles, requestor *messages.Requestor, width, widthMargin, height, heightMargin int) Model {
+// New constructs the explorer Model. The address book is optional.
+func New(styles *style.Styles, requestor *messages.Requestor, width, widthMargin, height, heightMargin int, book *config.AddressBook) Model {
 	m := Model{
 		state:        blockState,
 		style:        styles,
@@ -60,6 +65,7 @@ func New(styles *style.Styles, requestor *messages.Requestor, width, widthMargin
 		height:       height,
 		heightMargin: heightMargin,
 		requestor:    requestor,
+		book:         book,
 	}
 	m.initBlocks()
 	return m
@@ -160,16 +166,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch m.state {
 			case blockState:
 				// Select transactions.
-				m.state = paysetState
 				switch block := m.table.SelectedRow().(type) {
 				case BlockItem:
-					m.transactions = make([]transactionItem, 0)
-					for _, txn := range block.Block.Block.Payset {
-						t := txn
-						m.transactions = append(m.transactions, transactionItem{&t})
-					}
+					m.openBlock(block)
 				}
-				m.initTransactions()
 			case paysetState:
 				m.state = txnState
 				switch txn := m.table.SelectedRow().(type) {
@@ -192,6 +192,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.setSize(msg.Width, msg.Height)
 
+	case ShowTransactionMsg:
+		if !m.showTransaction(msg.Round, msg.Intra) {
+			return m, confirm.Ask(confirm.Request{
+				Title:       "Block explorer",
+				Description: fmt.Sprintf("Round %d is no longer in the block explorer, it only keeps the latest blocks.", msg.Round),
+			})
+		}
+		return m, func() tea.Msg { return TransactionShownMsg{} }
+
 	case BlocksMsg:
 		// append Blocks
 		backup := m.blocks
@@ -222,6 +231,50 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// ShowTransactionMsg opens a transaction in the explorer, Intra is the
+// position of the transaction in the block payset.
+type ShowTransactionMsg struct {
+	Round uint64
+	Intra int
+}
+
+// TransactionShownMsg is sent when the explorer opened the transaction of a
+// ShowTransactionMsg.
+type TransactionShownMsg struct{}
+
+// openBlock shows the transactions of a block.
+func (m *Model) openBlock(block BlockItem) {
+	m.state = paysetState
+	m.transactions = make([]transactionItem, 0)
+	for _, txn := range block.Block.Block.Payset {
+		t := txn
+		m.transactions = append(m.transactions, transactionItem{&t, m.book})
+	}
+	m.initTransactions()
+}
+
+// showTransaction opens a transaction of a tailed block, going back returns
+// to the list of transactions in the block. It returns false when the block
+// is no longer tailed.
+func (m *Model) showTransaction(round uint64, intra int) bool {
+	for _, block := range m.blocks {
+		if block.Round != round {
+			continue
+		}
+		if intra >= len(block.Block.Block.Payset) {
+			return false
+		}
+		m.openBlock(block)
+		for i := 0; i < intra; i++ {
+			m.table.GoDown()
+		}
+		m.state = txnState
+		m.initTransaction(m.transactions[intra].SignedTxnInBlock)
+		return true
+	}
+	return false
+}
+
 // View is part of the tea.Model interface.
 func (m Model) View() string {
 	switch m.state {
@@ -0,0 +1,51 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+func TestShowTransaction(t *testing.T) {
+	m := New(style.DefaultStyles(), nil, 80, 0, 40, 0, nil)
+	var block models.BlockResponse
+	block.Block.Payset = make(types.Payset, 2)
+	m.blocks = []BlockItem{{Round: 10, Block: block}}
+
+	tests := []struct {
+		name  string
+		msg   ShowTransactionMsg
+		shown bool
+	}{
+		{name: "tailed block", msg: ShowTransactionMsg{Round: 10, Intra: 1}, shown: true},
+		{name: "old block", msg: ShowTransactionMsg{Round: 9}},
+		{name: "missing transaction", msg: ShowTransactionMsg{Round: 10, Intra: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, cmd := m.Update(tt.msg)
+			if cmd == nil {
+				t.Fatal("expected a command")
+			}
+			switch msg := cmd().(type) {
+			case TransactionShownMsg:
+				if !tt.shown {
+					t.Error("transaction shown for a block which is not tailed")
+				}
+				if state := updated.(Model).state; state != txnState {
+					t.Errorf("state = %d, want the transaction view", state)
+				}
+			case confirm.RequestMsg:
+				if tt.shown {
+					t.Errorf("expected the transaction, got %q", msg.Description)
+				}
+			default:
+				t.Errorf("unexpected message %T", msg)
+			}
+		})
+	}
+}
@@ -8,11 +8,16 @@ import (
 	table "github.com/calyptia/go-bubble-table"
 
 	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/config"
 )
 
 // transactionItem is used by the list bubble.
 type transactionItem struct {
 	*types.SignedTxnInBlock
+
+	// book labels the sender.
+	book *config.AddressBook
 }
 
 func formatAmount(txn *types.SignedTxnInBlock) string {
@@ -39,13 +44,18 @@ func computeTxnRow(b transactionItem) string {
 		sigtype = "inner-txn"
 	}
 
+	sender := b.Txn.Sender.String()
+	if label, ok := b.book.Label(b.Txn.Sender); ok {
+		sender = label
+	}
+
 	return fmt.Sprintf("\t%s\t%s\t%s\t%f\t%t\t%s",
 		b.Txn.Type,
 		formatAmount(b.SignedTxnInBlock),
 		sigtype,
 		b.Txn.Fee.ToAlgos(),
 		len(b.Txn.Note) > 0,
-		b.Txn.Sender.String(),
+		sender,
 	)
 }
 
@@ -1,11 +1,14 @@
 package footer
 
 import (
+	"fmt"
+
 	"github.com/algorand/node-ui/messages"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/algorand/node-ui/tui/internal/alerts"
 	"github.com/algorand/node-ui/tui/internal/style"
 )
 
@@ -16,11 +19,13 @@ type Model struct {
 	style  *style.Styles
 
 	network messages.NetworkMsg
+	// alerts is optional, the number of alerts is shown when set.
+	alerts *alerts.Engine
 }
 
 // New creates the footer Model.
-func New(s *style.Styles) Model {
-	return Model{style: s}
+func New(s *style.Styles, engine *alerts.Engine) Model {
+	return Model{style: s, alerts: engine}
 }
 
 // Init is part of the tea.Model interface.
@@ -50,6 +55,11 @@ func (m Model) View() string {
 	right := m.style.FooterRight.Render(m.network.NodeVersion)
 	//middleText := fmt.Sprintf("%s (Gensis Hash %s)", m.network.GenesisID, m.network.GenesisHash)
 	middleText := m.network.GenesisID
+	if m.alerts != nil {
+		if count := len(m.alerts.Recent()); count > 0 {
+			middleText += fmt.Sprintf(" • %d alerts", count)
+		}
+	}
 
 	middle := m.style.FooterMiddle.Copy().
 		Width(m.width - lipgloss.Width(left) - lipgloss.Width(right)).
@@ -0,0 +1,86 @@
+package logs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algorand/node-ui/messages"
+)
+
+// paramsString renders the action parameters sorted by name.
+func paramsString(params map[string]interface{}) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%v", name, params[name]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// auditMatches checks an entry against the filter terms. A term of the form
+// field=value matches the user, action, fingerprint or a parameter, other
+// terms match anywhere in the row. Matching ignores case.
+func (m Model) auditMatches(entry messages.AuditEntry, row string) bool {
+	for _, term := range m.terms {
+		if field, value, ok := strings.Cut(term, "="); ok && field != "" {
+			var actual string
+			switch strings.ToLower(field) {
+			case "user":
+				actual = entry.User
+			case "action":
+				actual = entry.Action
+			case "fingerprint":
+				actual = entry.Fingerprint
+			default:
+				for name, param := range entry.Params {
+					if strings.EqualFold(name, field) {
+						actual = fmt.Sprint(param)
+					}
+				}
+			}
+			if !strings.Contains(strings.ToLower(actual), strings.ToLower(value)) {
+				return false
+			}
+		} else if !strings.Contains(strings.ToLower(row), strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// auditRow renders a single audit log entry, failed actions are highlighted.
+func (m Model) auditRow(entry messages.AuditEntry) string {
+	row := fmt.Sprintf("%s %-10s %-22s %s", entry.Time.Local().Format("2006-01-02 15:04:05"), entry.User, entry.Action, paramsString(entry.Params))
+	if entry.Error != "" {
+		return m.style.StatusCriticalText.Render(shorten(row+" → "+entry.Error, m.viewport.Width))
+	}
+	if entry.Response != "" {
+		row += " → " + entry.Response
+	}
+	return shorten(row, m.viewport.Width)
+}
+
+func (m Model) buildAuditString() string {
+	if m.auditErr != nil && len(m.auditEntries) == 0 {
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to read the audit log: %s", m.auditErr))
+	}
+
+	builder := strings.Builder{}
+	for _, entry := range m.auditEntries {
+		row := m.auditRow(entry)
+		if m.auditMatches(entry, row) {
+			builder.WriteString(row)
+			builder.WriteString("\n")
+		}
+	}
+	if builder.Len() == 0 {
+		return "No matching audit log entries."
+	}
+	return builder.String()
+}
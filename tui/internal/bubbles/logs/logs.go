@@ -0,0 +1,398 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/constants"
+	"github.com/algorand/node-ui/tui/internal/layout"
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+const (
+	// refresh is how often node.log is polled.
+	refresh = time.Second
+	// maxEntries is how many log lines are kept for scrolling back.
+	maxEntries = 5000
+)
+
+// levelRank orders the logrus levels used by algod.
+var levelRank = map[string]int{
+	"trace":   0,
+	"debug":   1,
+	"info":    2,
+	"warning": 3,
+	"error":   4,
+	"fatal":   5,
+	"panic":   6,
+}
+
+// minLevels are the level filters, the empty level shows every line.
+var minLevels = []string{"", "info", "warning", "error"}
+
+// logTickMsg triggers the next node.log poll.
+type logTickMsg struct{}
+
+// Model representing the node.log viewer.
+type Model struct {
+	entries  []messages.LogEntry
+	position messages.LogPosition
+	err      error
+	rotated  time.Time
+
+	// level indexes minLevels, terms are the filter terms.
+	level     int
+	filtering bool
+	filter    textinput.Model
+	terms     []string
+
+	// paused stops updating the view, pending counts the lines received
+	// since. follow keeps the newest line in view.
+	paused  bool
+	pending int
+	follow  bool
+
+	// showAudit shows the read only audit log instead of node.log.
+	showAudit    bool
+	audit        *messages.AuditLog
+	auditEntries []messages.AuditEntry
+	auditErr     error
+
+	style        *style.Styles
+	viewport     viewport.Model
+	heightMargin int
+	requestor    *messages.Requestor
+}
+
+// New creates the logs Model. The audit log is optional.
+func New(style *style.Styles, requestor *messages.Requestor, audit *messages.AuditLog, initialHeight, heightMargin int) Model {
+	filter := textinput.New()
+	filter.Prompt = "Filter: "
+	filter.Placeholder = "words, or field=value such as file=node.go"
+	filter.CharLimit = 128
+
+	m := Model{
+		filter:       filter,
+		follow:       true,
+		style:        style,
+		viewport:     viewport.New(0, 0),
+		heightMargin: heightMargin,
+		requestor:    requestor,
+		audit:        audit,
+	}
+	m.setSize(80, initialHeight)
+	return m
+}
+
+// Init is part of the tea.Model interface.
+func (m Model) Init() tea.Cmd {
+	return m.requestor.TailLogCmd(m.position)
+}
+
+// CapturesInput is true while the filter is being typed, so that global key
+// bindings are not triggered.
+func (m Model) CapturesInput() bool {
+	return m.filtering
+}
+
+func (m *Model) setSize(width, height int) {
+	headerHeight := lipgloss.Height(m.headerView())
+	footerHeight := lipgloss.Height(m.promptView()) + lipgloss.Height(m.footerView())
+	m.viewport.Width = width
+	// The viewport panics when following with a negative height.
+	m.viewport.Height = layout.Max(0, height-m.heightMargin-headerHeight-footerHeight)
+}
+
+// lookupField returns the value of a JSON field, the name is matched without
+// regard to case.
+func lookupField(fields map[string]string, name string) string {
+	if value, ok := fields[name]; ok {
+		return value
+	}
+	for field, value := range fields {
+		if strings.EqualFold(field, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+// matches checks an entry against the level and filter terms. A term of the
+// form field=value matches a JSON field, other terms match anywhere in the
+// line. Matching ignores case.
+func (m Model) matches(entry messages.LogEntry) bool {
+	if minimum := minLevels[m.level]; minimum != "" {
+		rank, ok := levelRank[entry.Level]
+		if !ok || rank < levelRank[minimum] {
+			return false
+		}
+	}
+	for _, term := range m.terms {
+		if field, value, ok := strings.Cut(term, "="); ok && field != "" {
+			if !strings.Contains(strings.ToLower(lookupField(entry.Fields, field)), strings.ToLower(value)) {
+				return false
+			}
+		} else if !strings.Contains(strings.ToLower(entry.Raw), strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// levelStyle colors a line by its level.
+func (m Model) levelStyle(level string) lipgloss.Style {
+	switch level {
+	case "error", "fatal", "panic":
+		return m.style.StatusCriticalText
+	case "warning":
+		return m.style.StatusWarningText
+	case "debug", "trace":
+		return m.style.AccountGrayText
+	}
+	return lipgloss.NewStyle()
+}
+
+// shorten truncates a line to the viewport width.
+func shorten(s string, n int) string {
+	if n <= 0 || len([]rune(s)) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
+
+// entryRow renders a single log line.
+func (m Model) entryRow(entry messages.LogEntry) string {
+	if entry.Fields == nil {
+		return shorten(entry.Raw, m.viewport.Width)
+	}
+	source := entry.File
+	if function := entry.Function; function != "" {
+		// Drop the package path from the function name.
+		source += " " + function[strings.LastIndex(function, "/")+1:]
+	}
+	row := fmt.Sprintf("%s %-7s %s  %s", entry.Time.Local().Format("15:04:05.000"), entry.Level, entry.Msg, source)
+	return m.levelStyle(entry.Level).Render(shorten(row, m.viewport.Width))
+}
+
+func (m Model) buildString() string {
+	if m.showAudit {
+		return m.buildAuditString()
+	}
+	if m.err != nil && len(m.entries) == 0 {
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to read node.log: %s", m.err))
+	}
+
+	builder := strings.Builder{}
+	for _, entry := range m.entries {
+		if m.matches(entry) {
+			builder.WriteString(m.entryRow(entry))
+			builder.WriteString("\n")
+		}
+	}
+	if builder.Len() == 0 {
+		return "No matching log lines."
+	}
+	return builder.String()
+}
+
+// refreshContent renders the log, keeping the newest line in view when
+// following.
+func (m *Model) refreshContent() {
+	m.viewport.SetContent(m.buildString())
+	if m.follow {
+		m.viewport.GotoBottom()
+	}
+}
+
+// addEntries appends new lines, dropping the oldest beyond maxEntries.
+func (m *Model) addEntries(entries []messages.LogEntry) {
+	// Copy the lines, the initial model is shared by SSH sessions.
+	updated := make([]messages.LogEntry, 0, len(m.entries)+len(entries))
+	updated = append(updated, m.entries...)
+	updated = append(updated, entries...)
+	if len(updated) > maxEntries {
+		updated = updated[len(updated)-maxEntries:]
+	}
+	m.entries = updated
+}
+
+// updateKeys handles the filter, pause and scrolling key bindings.
+func (m Model) updateKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.filtering {
+		switch msg.Type {
+		case tea.KeyEsc:
+			m.filter.Reset()
+			fallthrough
+		case tea.KeyEnter:
+			m.filtering = false
+			m.filter.Blur()
+		default:
+			m.filter, cmd = m.filter.Update(msg)
+		}
+		m.terms = strings.Fields(m.filter.Value())
+		m.refreshContent()
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, constants.Keys.Filter):
+		m.filtering = true
+		return m, m.filter.Focus()
+	case key.Matches(msg, constants.Keys.LogLevel):
+		m.level = (m.level + 1) % len(minLevels)
+		m.refreshContent()
+	case key.Matches(msg, constants.Keys.PauseLog):
+		m.paused = !m.paused
+		if !m.paused {
+			m.pending = 0
+			m.refreshContent()
+		}
+	case key.Matches(msg, constants.Keys.FollowLog):
+		m.follow = true
+		m.viewport.GotoBottom()
+	case key.Matches(msg, constants.Keys.AuditLog):
+		m.showAudit = !m.showAudit
+		m.follow = true
+		m.refreshContent()
+		if m.showAudit {
+			return m, m.audit.ReadCmd()
+		}
+	default:
+		m.viewport, cmd = m.viewport.Update(msg)
+		// Scrolling back stops following until the end is reached again.
+		m.follow = m.viewport.AtBottom()
+	}
+	return m, cmd
+}
+
+// Update is part of the tea.Model interface.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.updateKeys(msg)
+
+	case tea.WindowSizeMsg:
+		m.setSize(msg.Width, msg.Height)
+		m.refreshContent()
+
+	case tea.MouseMsg:
+		m.viewport, cmd = m.viewport.Update(msg)
+		m.follow = m.viewport.AtBottom()
+		return m, cmd
+
+	case logTickMsg:
+		if m.showAudit {
+			return m, tea.Batch(m.requestor.TailLogCmd(m.position), m.audit.ReadCmd())
+		}
+		return m, m.requestor.TailLogCmd(m.position)
+
+	case messages.AuditEntriesMsg:
+		m.auditEntries, m.auditErr = msg.Entries, msg.Err
+		if m.showAudit && !m.paused {
+			m.refreshContent()
+		}
+		return m, nil
+
+	case messages.AuditRecordedMsg:
+		// Show the new entry, or why it could not be written.
+		m.auditErr = msg.Err
+		if m.showAudit {
+			return m, m.audit.ReadCmd()
+		}
+		return m, nil
+
+	case messages.LogLinesMsg:
+		m.position, m.err = msg.Position, msg.Err
+		if msg.Rotated {
+			m.rotated = time.Now()
+		}
+		if len(msg.Entries) > 0 {
+			m.addEntries(msg.Entries)
+			if m.paused {
+				m.pending += len(msg.Entries)
+			} else if !m.showAudit {
+				m.refreshContent()
+			}
+		} else if m.err != nil && !m.paused && !m.showAudit {
+			m.refreshContent()
+		}
+		return m, tea.Tick(refresh, func(time.Time) tea.Msg {
+			return logTickMsg{}
+		})
+	}
+
+	// Handle mouse events in the viewport
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View is part of the tea.Model interface.
+func (m Model) View() string {
+	return fmt.Sprintf("%s\n%s\n%s\n%s", m.headerView(), m.viewport.View(), m.promptView(), m.footerView())
+}
+
+func (m Model) headerView() string {
+	text := "node.log"
+	if m.showAudit {
+		text = "audit log " + m.audit.Path()
+	} else if minimum := minLevels[m.level]; minimum != "" {
+		text += fmt.Sprintf(" • %s and above", minimum)
+	}
+	if len(m.terms) > 0 {
+		text += fmt.Sprintf(" • filter: %s", strings.Join(m.terms, " "))
+	}
+	switch {
+	case m.paused:
+		text += m.style.StatusWarningText.Render(fmt.Sprintf(" • paused, %d new lines", m.pending))
+	case m.follow:
+		text += " • following"
+	}
+	if !m.rotated.IsZero() {
+		text += fmt.Sprintf(" • rotated at %s", m.rotated.Format("15:04:05"))
+	}
+	return layout.Header(text, m.viewport.Width)
+}
+
+// promptView shows the filter input, the last read error or the key
+// bindings.
+func (m Model) promptView() string {
+	k := constants.Keys
+	switch {
+	case m.filtering:
+		return m.filter.View()
+	case m.showAudit && m.auditErr != nil && len(m.auditEntries) > 0:
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to use the audit log: %s", m.auditErr))
+	case !m.showAudit && m.err != nil && len(m.entries) > 0:
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to read node.log: %s", m.err))
+	default:
+		pause := k.PauseLog.Help().Desc
+		if m.paused {
+			pause = "resume"
+		}
+		source := k.AuditLog.Help().Desc
+		if m.showAudit {
+			source = "node.log"
+		}
+		return m.style.AccountHelpText.Render(fmt.Sprintf("%s: %s • %s: %s • %s: %s • %s: %s • %s: %s",
+			k.Filter.Help().Key, "filter",
+			k.LogLevel.Help().Key, k.LogLevel.Help().Desc,
+			k.PauseLog.Help().Key, pause,
+			k.FollowLog.Help().Key, k.FollowLog.Help().Desc,
+			k.AuditLog.Help().Key, source))
+	}
+}
+
+func (m Model) footerView() string {
+	return layout.Footer(m.viewport.ScrollPercent(), m.viewport.Width)
+}
@@ -0,0 +1,79 @@
+package logs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/algorand/node-ui/messages"
+)
+
+func TestMatches(t *testing.T) {
+	entry := messages.LogEntry{
+		Raw:   `{"Context":"Agreement","Round":1234,"Type":"ProposalAccepted","level":"info","msg":"Proposal Accepted"}`,
+		Level: "info",
+		Fields: map[string]string{
+			"Context": "Agreement",
+			"Round":   "1234",
+			"Type":    "ProposalAccepted",
+			"level":   "info",
+			"msg":     "Proposal Accepted",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		level  int
+		want   bool
+	}{
+		{name: "no filter", want: true},
+		{name: "word", filter: "proposal", want: true},
+		{name: "word with capitals", filter: "ACCEPTED", want: true},
+		{name: "missing word", filter: "vote", want: false},
+		{name: "capitalised field", filter: "Context=Agreement", want: true},
+		{name: "field name case", filter: "context=agreement", want: true},
+		{name: "field value case", filter: "Type=proposalaccepted", want: true},
+		{name: "partial field value", filter: "Round=123", want: true},
+		{name: "other field value", filter: "Context=Network", want: false},
+		{name: "unknown field", filter: "Peer=relay", want: false},
+		{name: "all terms", filter: "Context=Agreement accepted", want: true},
+		{name: "one term fails", filter: "Context=Agreement vote", want: false},
+		{name: "level passes", level: 1, want: true},
+		{name: "level filters", level: 2, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{terms: strings.Fields(tt.filter), level: tt.level}
+			if got := m.matches(entry); got != tt.want {
+				t.Errorf("matches(%q) = %v, want %v", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditMatches(t *testing.T) {
+	entry := messages.AuditEntry{
+		User:   "alice",
+		Action: "config.edit",
+		Params: map[string]interface{}{"Archival": true},
+	}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{filter: "user=alice", want: true},
+		{filter: "User=ALICE", want: true},
+		{filter: "action=participation", want: false},
+		{filter: "archival=true", want: true},
+		{filter: "Archival=false", want: false},
+		{filter: "CONFIG", want: true},
+	}
+	for _, tt := range tests {
+		m := Model{terms: strings.Fields(tt.filter)}
+		row := entry.User + " " + entry.Action
+		if got := m.auditMatches(entry, row); got != tt.want {
+			t.Errorf("auditMatches(%q) = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,426 @@
+package participation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/config"
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
+	"github.com/algorand/node-ui/tui/internal/bubbles/explorer"
+	"github.com/algorand/node-ui/tui/internal/constants"
+	"github.com/algorand/node-ui/tui/internal/layout"
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+// refresh is how often the participation keys are polled.
+const refresh = 10 * time.Second
+
+// errViewer is shown when a viewer tries to change the keys.
+var errViewer = errors.New("participation keys can only be managed with the operator role")
+
+// mode is the input the tab is waiting for.
+type mode int
+
+const (
+	browsing mode = iota
+	installing
+	generating
+)
+
+// keysTickMsg triggers the next participation key poll.
+type keysTickMsg struct{}
+
+// keysRefreshedMsg has the keys fetched after an action, outside of the
+// regular poll.
+type keysRefreshedMsg messages.ParticipationKeysMsg
+
+// Model representing the participation keys bubble.
+type Model struct {
+	keys     []messages.ParticipationKey
+	err      error
+	selected int
+
+	mode  mode
+	input textinput.Model
+	// result is the outcome of the last action.
+	result    string
+	resultErr error
+
+	// proposals counts the blocks proposed by each address this session.
+	proposals map[string]uint64
+	lastRound uint64
+
+	style        *style.Styles
+	viewport     viewport.Model
+	heightMargin int
+	requestor    *messages.Requestor
+	// book is optional, it labels the key addresses.
+	book *config.AddressBook
+	// viewer hides and rejects the key management bindings.
+	viewer bool
+}
+
+// New creates the participation Model. The address book is optional.
+func New(style *style.Styles, requestor *messages.Requestor, initialHeight, heightMargin int, book *config.AddressBook) Model {
+	input := textinput.New()
+	input.CharLimit = 256
+
+	m := Model{
+		input:        input,
+		proposals:    make(map[string]uint64),
+		style:        style,
+		viewport:     viewport.New(0, 0),
+		heightMargin: heightMargin,
+		requestor:    requestor,
+		book:         book,
+	}
+	m.setSize(80, initialHeight)
+	return m
+}
+
+// Init is part of the tea.Model interface.
+func (m Model) Init() tea.Cmd {
+	return m.requestor.GetParticipationKeysCmd()
+}
+
+// WithOperator returns the Model for a session, key management is only
+// available to operators.
+func (m Model) WithOperator(operator bool) tea.Model {
+	m.viewer = !operator
+	return m
+}
+
+// CapturesInput is true while waiting for text input or a confirmation, so
+// that global key bindings are not triggered.
+func (m Model) CapturesInput() bool {
+	return m.mode != browsing
+}
+
+func (m *Model) setSize(width, height int) {
+	headerHeight := lipgloss.Height(m.headerView())
+	footerHeight := lipgloss.Height(m.promptView()) + lipgloss.Height(m.footerView())
+	m.viewport.Width = width
+	m.viewport.Height = height - m.heightMargin - headerHeight - footerHeight
+}
+
+// startInput switches to a text input mode.
+func (m *Model) startInput(mode mode, prompt, placeholder string) tea.Cmd {
+	m.mode = mode
+	m.input.Prompt = prompt
+	m.input.Placeholder = placeholder
+	m.input.Reset()
+	return m.input.Focus()
+}
+
+// generateRequest parses "ADDRESS FIRST LAST [DILUTION]" into a key
+// generation to confirm.
+func (m Model) generateRequest(input string) (confirm.Request, error) {
+	fields := strings.Fields(input)
+	if len(fields) != 3 && len(fields) != 4 {
+		return confirm.Request{}, fmt.Errorf("expected: address first last [dilution]")
+	}
+	if _, err := types.DecodeAddress(fields[0]); err != nil {
+		return confirm.Request{}, fmt.Errorf("invalid address: %w", err)
+	}
+
+	var rounds [3]uint64
+	for i, field := range fields[1:] {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return confirm.Request{}, fmt.Errorf("invalid number '%s'", field)
+		}
+		rounds[i] = value
+	}
+	if rounds[0] >= rounds[1] {
+		return confirm.Request{}, fmt.Errorf("first round must be before the last round")
+	}
+
+	dilution := "default"
+	if rounds[2] != 0 {
+		dilution = fmt.Sprintf("%d", rounds[2])
+	}
+	return confirm.Request{
+		Title:       "Generate participation key",
+		Description: "The node generates a new key for the account, which can take several minutes and uses the node CPU.",
+		Details: []confirm.Detail{
+			{Label: "Address", Value: m.displayName(fields[0])},
+			{Label: "Valid", Value: fmt.Sprintf("%d-%d", rounds[0], rounds[1])},
+			{Label: "Dilution", Value: dilution},
+		},
+		Confirm: m.requestor.GenerateParticipationKeysCmd(fields[0], rounds[0], rounds[1], rounds[2]),
+	}, nil
+}
+
+// installRequest describes installing a key file to confirm.
+func (m Model) installRequest(path string) (confirm.Request, error) {
+	if path == "" {
+		return confirm.Request{}, fmt.Errorf("expected the path of a .partkey file")
+	}
+	return confirm.Request{
+		Title:       "Install participation key",
+		Description: "The key file is read on this machine and uploaded to the node, which keeps the key until it is deleted.",
+		Details:     []confirm.Detail{{Label: "Key file", Value: path}},
+		Confirm:     m.requestor.InstallParticipationKeyCmd(path),
+	}, nil
+}
+
+// updateInput handles keys while waiting for input.
+func (m Model) updateInput(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.mode = browsing
+		m.resultErr = nil
+		m.input.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		var request confirm.Request
+		var err error
+		if m.mode == installing {
+			request, err = m.installRequest(strings.TrimSpace(m.input.Value()))
+		} else {
+			request, err = m.generateRequest(m.input.Value())
+		}
+		if err != nil {
+			m.resultErr = err
+			return m, nil
+		}
+		m.mode = browsing
+		m.result, m.resultErr = "", nil
+		m.input.Blur()
+		return m, confirm.Ask(request)
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// confirmDelete asks for a confirmation before deleting a key.
+func (m Model) confirmDelete(k messages.ParticipationKey) tea.Cmd {
+	return confirm.Ask(confirm.Request{
+		Title:       "Delete participation key",
+		Description: "The key is removed from the node. An account registered online with it stops voting until a new key is installed and registered.",
+		Details: []confirm.Detail{
+			{Label: "Key", Value: k.ID},
+			{Label: "Address", Value: m.displayName(k.Address)},
+			{Label: "Valid", Value: fmt.Sprintf("%d-%d", k.Key.VoteFirstValid, k.Key.VoteLastValid)},
+		},
+		Confirm: m.requestor.DeleteParticipationKeyCmd(k.ID),
+	})
+}
+
+// updateKeys handles the participation key bindings.
+func (m Model) updateKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.mode != browsing {
+		return m.updateInput(msg)
+	}
+
+	var cmd tea.Cmd
+	switch {
+	case m.viewer && (key.Matches(msg, constants.Keys.InstallKey) ||
+		key.Matches(msg, constants.Keys.GenerateKey) ||
+		key.Matches(msg, constants.Keys.DeleteKey)):
+		m.result, m.resultErr = "manage keys", errViewer
+	case key.Matches(msg, constants.Keys.InstallKey):
+		cmd = m.startInput(installing, "Key file: ", "path to a .partkey file on this machine")
+	case key.Matches(msg, constants.Keys.GenerateKey):
+		cmd = m.startInput(generating, "Generate: ", "address first-round last-round [dilution]")
+	case key.Matches(msg, constants.Keys.DeleteKey):
+		if m.selected < len(m.keys) {
+			cmd = m.confirmDelete(m.keys[m.selected])
+		}
+	case key.Matches(msg, constants.Keys.Up):
+		if m.selected > 0 {
+			m.selected--
+		}
+	case key.Matches(msg, constants.Keys.Down):
+		if m.selected < len(m.keys)-1 {
+			m.selected++
+		}
+	default:
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	m.viewport.SetContent(m.buildString())
+	// Keep the selected row visible, each key is one line.
+	switch {
+	case m.selected < m.viewport.YOffset:
+		m.viewport.SetYOffset(m.selected)
+	case m.selected >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(m.selected - m.viewport.Height + 1)
+	}
+	return m, cmd
+}
+
+// Update is part of the tea.Model interface.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.updateKeys(msg)
+
+	case tea.WindowSizeMsg:
+		m.setSize(msg.Width, msg.Height)
+
+	case keysTickMsg:
+		return m, m.requestor.GetParticipationKeysCmd()
+
+	case messages.ParticipationKeysMsg:
+		m.setKeys(msg)
+		return m, tea.Tick(refresh, func(time.Time) tea.Msg {
+			return keysTickMsg{}
+		})
+
+	case keysRefreshedMsg:
+		m.setKeys(messages.ParticipationKeysMsg(msg))
+		return m, nil
+
+	case messages.ParticipationActionMsg:
+		m.result, m.resultErr = msg.Action, msg.Err
+		// Refresh right away to show the change.
+		requestor := m.requestor
+		return m, func() tea.Msg {
+			return keysRefreshedMsg(requestor.GetParticipationKeysCmd()().(messages.ParticipationKeysMsg))
+		}
+
+	case explorer.BlocksMsg:
+		// Copy the counts, the initial model is shared by SSH sessions.
+		proposals := make(map[string]uint64, len(m.proposals)+1)
+		for addr, count := range m.proposals {
+			proposals[addr] = count
+		}
+		latest := m.lastRound
+		for _, blk := range msg.Blocks {
+			if blk.Round <= m.lastRound {
+				continue
+			}
+			proposals[explorer.Proposer(blk.Block.Cert)]++
+			if blk.Round > latest {
+				latest = blk.Round
+			}
+		}
+		m.proposals = proposals
+		m.lastRound = latest
+		m.viewport.SetContent(m.buildString())
+	}
+
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// setKeys replaces the key list, keeping the selection in range.
+func (m *Model) setKeys(msg messages.ParticipationKeysMsg) {
+	m.keys, m.err = msg.Keys, msg.Err
+	if m.selected >= len(m.keys) {
+		m.selected = layout.Max(0, len(m.keys)-1)
+	}
+	m.viewport.SetContent(m.buildString())
+}
+
+// shorten abbreviates long identifiers to fit in the table.
+func shorten(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}
+
+func (m Model) buildString() string {
+	if m.err != nil {
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Unable to list participation keys: %s", m.err))
+	}
+	if len(m.keys) == 0 {
+		return "No participation keys installed."
+	}
+
+	builder := strings.Builder{}
+	for i, k := range m.keys {
+		cursor := " "
+		if i == m.selected {
+			cursor = ">"
+		}
+		builder.WriteString(fmt.Sprintf("%s %-12s %-12s %21s %11s %10s %10s %9d\n",
+			m.style.BottomListItemSelector.Copy().MarginLeft(0).Render(cursor),
+			shorten(k.ID, 12),
+			shorten(m.displayName(k.Address), 12),
+			fmt.Sprintf("%d-%d", k.Key.VoteFirstValid, k.Key.VoteLastValid),
+			roundString(k.EffectiveFirstValid),
+			roundString(k.LastVote),
+			roundString(k.LastBlockProposal),
+			m.proposals[k.Address]))
+	}
+	return builder.String()
+}
+
+// displayName returns the address book label of an address when there is one.
+func (m Model) displayName(address string) string {
+	if addr, err := types.DecodeAddress(address); err == nil {
+		if label, ok := m.book.Label(addr); ok {
+			return label
+		}
+	}
+	return address
+}
+
+// roundString renders an optional round, zero means never.
+func roundString(round uint64) string {
+	if round == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", round)
+}
+
+// View is part of the tea.Model interface.
+func (m Model) View() string {
+	return fmt.Sprintf("%s\n%s\n%s\n%s", m.headerView(), m.viewport.View(), m.promptView(), m.footerView())
+}
+
+func (m Model) headerView() string {
+	title := layout.Header("Participation keys", m.viewport.Width)
+	header := fmt.Sprintf("  %-12s %-12s %21s %11s %10s %10s %9s",
+		"ID", "Address", "Valid", "Effective", "Last vote", "Last prop", "Proposals")
+	return lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		m.style.AccountBoldText.Render(header))
+}
+
+// promptView shows the pending input, the last action result or the key
+// bindings.
+func (m Model) promptView() string {
+	switch {
+	case m.mode != browsing && m.resultErr != nil:
+		return fmt.Sprintf("%s  %s", m.input.View(), m.style.StatusCriticalText.Render(m.resultErr.Error()))
+	case m.mode != browsing:
+		return m.input.View()
+	case m.resultErr != nil:
+		return m.style.StatusCriticalText.Render(fmt.Sprintf("Failed to %s: %s", m.result, m.resultErr))
+	case m.result != "":
+		return m.style.AccountHelpText.Render(m.result + ": done")
+	case m.viewer:
+		return m.style.AccountHelpText.Render("read only, keys are managed with the operator role")
+	default:
+		k := constants.Keys
+		return m.style.AccountHelpText.Render(fmt.Sprintf("%s: %s • %s: %s • %s: %s",
+			k.InstallKey.Help().Key, k.InstallKey.Help().Desc,
+			k.GenerateKey.Help().Key, k.GenerateKey.Help().Desc,
+			k.DeleteKey.Help().Key, k.DeleteKey.Help().Desc))
+	}
+}
+
+func (m Model) footerView() string {
+	return layout.Footer(m.viewport.ScrollPercent(), m.viewport.Width)
+}
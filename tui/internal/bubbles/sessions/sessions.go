@@ -0,0 +1,157 @@
+package sessions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/layout"
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+// refresh is how often the session list is updated.
+const refresh = 2 * time.Second
+
+// sessionsTickMsg triggers the next session list update.
+type sessionsTickMsg struct{}
+
+// Model representing the SSH session list.
+type Model struct {
+	sessions []messages.Session
+	serving  bool
+	// viewer hides the list, it is only shown to operators.
+	viewer bool
+
+	style        *style.Styles
+	viewport     viewport.Model
+	heightMargin int
+	// source lists the sessions, it is nil when not served over SSH.
+	source func() []messages.Session
+}
+
+// New creates the sessions Model. The source is nil when the UI is not served
+// over SSH.
+func New(style *style.Styles, source func() []messages.Session, initialHeight, heightMargin int) Model {
+	m := Model{
+		style:        style,
+		viewport:     viewport.New(0, 0),
+		heightMargin: heightMargin,
+		source:       source,
+	}
+	m.setSize(80, initialHeight)
+	return m
+}
+
+// Init is part of the tea.Model interface.
+func (m Model) Init() tea.Cmd {
+	return messages.GetSessionsCmd(m.source)
+}
+
+// WithOperator returns the Model for a session, the session list is only
+// shown to operators.
+func (m Model) WithOperator(operator bool) tea.Model {
+	m.viewer = !operator
+	m.viewport.SetContent(m.buildString())
+	return m
+}
+
+func (m *Model) setSize(width, height int) {
+	headerHeight := lipgloss.Height(m.headerView())
+	footerHeight := lipgloss.Height(m.footerView())
+	m.viewport.Width = width
+	// The margins do not fit in a very small terminal.
+	m.viewport.Height = layout.Max(0, height-m.heightMargin-headerHeight-footerHeight)
+}
+
+// shorten truncates a string to n characters.
+func shorten(s string, n int) string {
+	if len([]rune(s)) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
+
+// durationString renders a duration rounded to the second.
+func durationString(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
+
+func (m Model) buildString() string {
+	switch {
+	case m.viewer:
+		return "The session list requires the operator role."
+	case !m.serving:
+		return "Sessions are listed when the UI is served over SSH with -p."
+	}
+
+	now := time.Now()
+	builder := strings.Builder{}
+	for _, s := range m.sessions {
+		fingerprint := s.Fingerprint
+		if fingerprint == "" {
+			fingerprint = "-"
+		}
+		builder.WriteString(fmt.Sprintf("%-12s %-8s %-22s %-21s %s %-9s %s\n",
+			shorten(s.User, 12),
+			s.Role,
+			shorten(fingerprint, 22),
+			shorten(s.RemoteAddr, 21),
+			s.Since.Format("2006-01-02 15:04:05"),
+			durationString(now.Sub(s.Since)),
+			durationString(now.Sub(s.LastInput))))
+	}
+	return builder.String()
+}
+
+// Update is part of the tea.Model interface.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.setSize(msg.Width, msg.Height)
+
+	case sessionsTickMsg:
+		return m, messages.GetSessionsCmd(m.source)
+
+	case messages.SessionsMsg:
+		m.sessions, m.serving = msg.Sessions, msg.Serving
+		m.viewport.SetContent(m.buildString())
+		if !msg.Serving {
+			return m, nil
+		}
+		return m, tea.Tick(refresh, func(time.Time) tea.Msg {
+			return sessionsTickMsg{}
+		})
+	}
+
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View is part of the tea.Model interface.
+func (m Model) View() string {
+	return fmt.Sprintf("%s\n%s\n%s", m.headerView(), m.viewport.View(), m.footerView())
+}
+
+func (m Model) headerView() string {
+	text := "SSH sessions"
+	if m.serving && !m.viewer {
+		text = fmt.Sprintf("%d SSH sessions", len(m.sessions))
+	}
+	title := layout.Header(text, m.viewport.Width)
+	header := fmt.Sprintf("%-12s %-8s %-22s %-21s %-19s %-9s %s",
+		"USER", "ROLE", "KEY", "REMOTE", "CONNECTED", "FOR", "IDLE")
+	return lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		m.style.AccountBoldText.Render(header))
+}
+
+func (m Model) footerView() string {
+	return layout.Footer(m.viewport.ScrollPercent(), m.viewport.Width)
+}
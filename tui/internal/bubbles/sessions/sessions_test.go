@@ -0,0 +1,26 @@
+package sessions
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+func TestSetSize(t *testing.T) {
+	tests := []struct {
+		height int
+		want   int
+	}{
+		{height: 40, want: 13},
+		{height: 3, want: 0},
+	}
+	for _, tt := range tests {
+		m := New(style.DefaultStyles(), nil, 40, 20)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: tt.height})
+		if got := updated.(Model).viewport.Height; got != tt.want {
+			t.Errorf("viewport height for a %d line window = %d, want %d", tt.height, got, tt.want)
+		}
+	}
+}
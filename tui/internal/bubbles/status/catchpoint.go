@@ -0,0 +1,135 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+)
+
+// catchpointPhase is a stage of fast catchup, algod runs them in order.
+type catchpointPhase int
+
+const (
+	accountsPhase catchpointPhase = iota
+	kvsPhase
+	verifyPhase
+	blocksPhase
+	numPhases
+)
+
+var phaseNames = [numPhases]string{
+	accountsPhase: "Accounts",
+	kvsPhase:      "KVs",
+	verifyPhase:   "Verify",
+	blocksPhase:   "Blocks",
+}
+
+var phaseDescriptions = [numPhases]string{
+	accountsPhase: "Downloading accounts",
+	kvsPhase:      "Downloading KVs",
+	verifyPhase:   "Verifying accounts and KVs",
+	blocksPhase:   "Downloading blocks",
+}
+
+// phaseBarWidth is the width of the progress bars, including the percentage.
+const phaseBarWidth = 24
+
+// currentPhase infers the active phase from the status counters.
+func currentPhase(status models.NodeStatus) catchpointPhase {
+	switch {
+	case status.CatchpointTotalBlocks > 0:
+		return blocksPhase
+	case status.CatchpointVerifiedAccounts > 0 || status.CatchpointVerifiedKvs > 0:
+		return verifyPhase
+	case status.CatchpointProcessedKvs > 0:
+		return kvsPhase
+	default:
+		return accountsPhase
+	}
+}
+
+// phaseCounters returns the done and total counters of a phase.
+func phaseCounters(status models.NodeStatus, phase catchpointPhase) (uint64, uint64) {
+	switch phase {
+	case accountsPhase:
+		return status.CatchpointProcessedAccounts, status.CatchpointTotalAccounts
+	case kvsPhase:
+		return status.CatchpointProcessedKvs, status.CatchpointTotalKvs
+	case verifyPhase:
+		return status.CatchpointVerifiedAccounts + status.CatchpointVerifiedKvs,
+			status.CatchpointTotalAccounts + status.CatchpointTotalKvs
+	default:
+		return status.CatchpointAcquiredBlocks, status.CatchpointTotalBlocks
+	}
+}
+
+// catchpointTracker follows the progress of a fast catchup.
+type catchpointTracker struct {
+	catchpoint string
+	phase      catchpointPhase
+	// rate is reset at the start of each phase.
+	rate rateTracker
+}
+
+// observe records the fast catchup progress from a status update.
+func (c catchpointTracker) observe(status models.NodeStatus, now time.Time) catchpointTracker {
+	if status.Catchpoint == "" {
+		return catchpointTracker{}
+	}
+	if status.Catchpoint != c.catchpoint {
+		c = catchpointTracker{catchpoint: status.Catchpoint}
+	}
+
+	phase := currentPhase(status)
+	if phase != c.phase {
+		c.phase = phase
+		c.rate = rateTracker{}
+	}
+	done, _ := phaseCounters(status, phase)
+	c.rate = c.rate.observe(done, now)
+	return c
+}
+
+// pct returns the completion of a phase, earlier phases are complete.
+func (c catchpointTracker) pct(status models.NodeStatus, phase catchpointPhase) float64 {
+	if phase < c.phase {
+		return 1
+	}
+	done, total := phaseCounters(status, phase)
+	if total == 0 {
+		return 0
+	}
+	return float64(done) / float64(total)
+}
+
+// catchpointView renders the phase-aware fast catchup panel.
+func (m Model) catchpointView() string {
+	bold := m.style.StatusBoldText
+	key := m.style.BottomListItemKey.Copy().MarginLeft(0)
+	bar := m.progress
+	bar.Width = phaseBarWidth
+
+	builder := strings.Builder{}
+	// algod reports the time since the catchpoint catchup started as the
+	// catchup time.
+	builder.WriteString(fmt.Sprintf("Catchpoint: %s  Elapsed: %s\n",
+		key.Render(strings.Split(m.Status.Catchpoint, "#")[0]),
+		time.Duration(m.Status.CatchupTime).Round(time.Second)))
+	done, total := phaseCounters(m.Status, m.catchpoint.phase)
+	builder.WriteString(bold.Render(fmt.Sprintf("%s: %d / %d", phaseDescriptions[m.catchpoint.phase], done, total)))
+	builder.WriteString("\n")
+	for phase := accountsPhase; phase < numPhases; phase++ {
+		label := fmt.Sprintf("%-9s", phaseNames[phase])
+		var detail string
+		if phase == m.catchpoint.phase {
+			label = bold.Render(label)
+			if rate := m.catchpoint.rate.rate(); rate > 0 {
+				detail = fmt.Sprintf("%.0f/s, %s to go", rate, m.catchpoint.rate.eta(done, total).Round(time.Second))
+			}
+		}
+		builder.WriteString(fmt.Sprintf("%s %s %s\n", label, bar.ViewAs(m.catchpoint.pct(m.Status, phase)), detail))
+	}
+	return builder.String()
+}
@@ -0,0 +1,54 @@
+package status
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+func TestCatchpointTracker(t *testing.T) {
+	now := time.Now()
+	status := models.NodeStatus{
+		Catchpoint:                  "1000#ABC",
+		CatchpointTotalAccounts:     100,
+		CatchpointProcessedAccounts: 10,
+	}
+	c := catchpointTracker{}.observe(status, now)
+	if c.phase != accountsPhase {
+		t.Fatalf("phase = %d, want accounts", c.phase)
+	}
+
+	status.CatchpointProcessedAccounts = 100
+	status.CatchpointTotalKvs = 50
+	status.CatchpointProcessedKvs = 25
+	c = c.observe(status, now.Add(time.Second))
+	if c.phase != kvsPhase {
+		t.Fatalf("phase = %d, want KVs", c.phase)
+	}
+	if got := c.pct(status, accountsPhase); got != 1 {
+		t.Errorf("accounts pct = %v, want 1 for a finished phase", got)
+	}
+	if got := c.pct(status, kvsPhase); got != 0.5 {
+		t.Errorf("KVs pct = %v, want 0.5", got)
+	}
+	if got := c.pct(status, blocksPhase); got != 0 {
+		t.Errorf("blocks pct = %v, want 0", got)
+	}
+
+	if c = c.observe(models.NodeStatus{}, now); c.catchpoint != "" {
+		t.Errorf("tracker kept %s after catchup finished", c.catchpoint)
+	}
+}
+
+func TestCatchpointViewElapsed(t *testing.T) {
+	m := New(style.DefaultStyles(), nil, Options{})
+	m.Status = models.NodeStatus{Catchpoint: "1000#ABC", CatchupTime: uint64(90 * time.Second)}
+	m.catchpoint = m.catchpoint.observe(m.Status, time.Now())
+	if view := m.catchpointView(); !strings.Contains(view, "Elapsed: 1m30s") {
+		t.Errorf("expected the elapsed time reported by the node:\n%s", view)
+	}
+}
@@ -0,0 +1,95 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+)
+
+// HealthThresholds configures when the node is reported as unhealthy.
+type HealthThresholds struct {
+	// Stall is how long to wait for a new round before the node is considered stalled.
+	Stall time.Duration
+	// Catchup is how long the node may be catching up before it is flagged.
+	Catchup time.Duration
+	// Behind and BehindCritical are how many rounds the node may be behind
+	// the network round before it gets a warning or critical state.
+	Behind         uint64
+	BehindCritical uint64
+}
+
+type healthLevel int
+
+const (
+	healthy healthLevel = iota
+	warning
+	critical
+)
+
+// health is the result of evaluating the node status.
+type health struct {
+	level  healthLevel
+	reason string
+}
+
+// evaluateHealth checks the node status against the thresholds, the most
+// severe problem is reported. The network round is 0 when it is unknown.
+func evaluateHealth(status models.NodeStatus, networkRound uint64, thresholds HealthThresholds) health {
+	switch {
+	case status.StoppedAtUnsupportedRound:
+		return health{critical, "Stopped, next protocol is not supported."}
+	case !status.NextVersionSupported && status.NextVersionRound != 0 && status.LastRound+1 >= status.NextVersionRound:
+		return health{critical, fmt.Sprintf("Stopped at upgrade to %s.", formatVersion(status.NextVersion))}
+	}
+
+	sinceLastRound := time.Duration(status.TimeSinceLastRound)
+	if status.Catchpoint == "" && thresholds.Stall > 0 && sinceLastRound > thresholds.Stall {
+		return health{critical, fmt.Sprintf("No new round for %s.", sinceLastRound.Round(time.Second))}
+	}
+
+	var behind uint64
+	if networkRound > status.LastRound {
+		behind = networkRound - status.LastRound
+	}
+	behindReason := fmt.Sprintf("%d rounds behind the network.", behind)
+	if thresholds.BehindCritical > 0 && behind > thresholds.BehindCritical {
+		return health{critical, behindReason}
+	}
+	if thresholds.Behind > 0 && behind > thresholds.Behind {
+		return health{warning, behindReason}
+	}
+
+	catchupTime := time.Duration(status.CatchupTime)
+	if thresholds.Catchup > 0 && catchupTime > thresholds.Catchup {
+		return health{warning, fmt.Sprintf("Catching up for %s.", catchupTime.Round(time.Second))}
+	}
+
+	return health{level: healthy}
+}
+
+// borderColor returns the status border color for a health level.
+func (m Model) borderColor(level healthLevel) lipgloss.Color {
+	switch level {
+	case critical:
+		return m.style.CriticalBorderColor
+	case warning:
+		return m.style.WarningBorderColor
+	default:
+		return m.style.InactiveBorderColor
+	}
+}
+
+// textStyle returns the text style for a health level.
+func (m Model) textStyle(level healthLevel) lipgloss.Style {
+	switch level {
+	case critical:
+		return m.style.StatusCriticalText
+	case warning:
+		return m.style.StatusWarningText
+	default:
+		return lipgloss.NewStyle()
+	}
+}
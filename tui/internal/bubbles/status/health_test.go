@@ -0,0 +1,104 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+)
+
+func TestEvaluateHealth(t *testing.T) {
+	thresholds := HealthThresholds{
+		Stall:          20 * time.Second,
+		Catchup:        5 * time.Minute,
+		Behind:         10,
+		BehindCritical: 1000,
+	}
+	tests := []struct {
+		name         string
+		status       models.NodeStatus
+		networkRound uint64
+		thresholds   HealthThresholds
+		level        healthLevel
+		reason       string
+	}{
+		{
+			name:         "healthy",
+			status:       models.NodeStatus{LastRound: 100, TimeSinceLastRound: uint64(time.Second)},
+			networkRound: 101,
+			thresholds:   thresholds,
+			level:        healthy,
+		},
+		{
+			name:       "unsupported round",
+			status:     models.NodeStatus{LastRound: 100, StoppedAtUnsupportedRound: true},
+			thresholds: thresholds,
+			level:      critical,
+			reason:     "Stopped, next protocol is not supported.",
+		},
+		{
+			name:       "stalled",
+			status:     models.NodeStatus{LastRound: 100, TimeSinceLastRound: uint64(30 * time.Second)},
+			thresholds: thresholds,
+			level:      critical,
+			reason:     "No new round for 30s.",
+		},
+		{
+			name:       "stall is ignored during fast catchup",
+			status:     models.NodeStatus{Catchpoint: "1000#ABC", TimeSinceLastRound: uint64(30 * time.Second)},
+			thresholds: thresholds,
+			level:      healthy,
+		},
+		{
+			name:       "catching up for too long",
+			status:     models.NodeStatus{LastRound: 100, CatchupTime: uint64(6 * time.Minute)},
+			thresholds: thresholds,
+			level:      warning,
+			reason:     "Catching up for 6m0s.",
+		},
+		{
+			name:         "behind",
+			status:       models.NodeStatus{LastRound: 100},
+			networkRound: 150,
+			thresholds:   thresholds,
+			level:        warning,
+			reason:       "50 rounds behind the network.",
+		},
+		{
+			name:         "far behind",
+			status:       models.NodeStatus{LastRound: 100, CatchupTime: uint64(6 * time.Minute)},
+			networkRound: 5000,
+			thresholds:   thresholds,
+			level:        critical,
+			reason:       "4900 rounds behind the network.",
+		},
+		{
+			name:         "at the threshold",
+			status:       models.NodeStatus{LastRound: 100},
+			networkRound: 110,
+			thresholds:   thresholds,
+			level:        healthy,
+		},
+		{
+			name:         "ahead of a stale network round",
+			status:       models.NodeStatus{LastRound: 200},
+			networkRound: 100,
+			thresholds:   thresholds,
+			level:        healthy,
+		},
+		{
+			name:         "disabled thresholds",
+			status:       models.NodeStatus{LastRound: 100, TimeSinceLastRound: uint64(time.Hour), CatchupTime: uint64(time.Hour)},
+			networkRound: 5000,
+			level:        healthy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluateHealth(tt.status, tt.networkRound, tt.thresholds)
+			if got.level != tt.level || got.reason != tt.reason {
+				t.Errorf("evaluateHealth() = %d %q, want %d %q", got.level, got.reason, tt.level, tt.reason)
+			}
+		})
+	}
+}
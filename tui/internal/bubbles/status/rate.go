@@ -0,0 +1,51 @@
+package status
+
+import "time"
+
+// rateWindow is how far back rates are measured.
+const rateWindow = 30 * time.Second
+
+type rateSample struct {
+	value uint64
+	time  time.Time
+}
+
+// rateTracker measures how fast a counter grows over a rolling window.
+type rateTracker struct {
+	samples []rateSample
+}
+
+// observe records the counter value at a time. Samples older than the
+// window are dropped, a new slice is always allocated so that copies of the
+// model never share state.
+func (r rateTracker) observe(value uint64, t time.Time) rateTracker {
+	samples := make([]rateSample, 0, len(r.samples)+1)
+	for _, sample := range r.samples {
+		if t.Sub(sample.time) <= rateWindow {
+			samples = append(samples, sample)
+		}
+	}
+	return rateTracker{samples: append(samples, rateSample{value: value, time: t})}
+}
+
+// rate returns the growth of the counter per second.
+func (r rateTracker) rate() float64 {
+	if len(r.samples) < 2 {
+		return 0
+	}
+	first, last := r.samples[0], r.samples[len(r.samples)-1]
+	elapsed := last.time.Sub(first.time).Seconds()
+	if elapsed <= 0 || last.value < first.value {
+		return 0
+	}
+	return float64(last.value-first.value) / elapsed
+}
+
+// eta returns the time until the counter reaches the total at the current rate.
+func (r rateTracker) eta(value, total uint64) time.Duration {
+	rate := r.rate()
+	if rate == 0 || value >= total {
+		return 0
+	}
+	return time.Duration(float64(total-value) / rate * float64(time.Second))
+}
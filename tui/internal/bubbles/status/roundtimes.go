@@ -0,0 +1,125 @@
+package status
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// roundTimeWindow is the number of recent rounds kept for round time statistics.
+const roundTimeWindow = 40
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+type roundSample struct {
+	round uint64
+	time  time.Time
+	// precise is set when the time was observed from a status update rather
+	// than a block header, which only has second resolution.
+	precise bool
+}
+
+// roundTimes is a rolling window of round start times used to compute
+// per-round durations.
+type roundTimes struct {
+	samples []roundSample
+}
+
+// observe records the time a round was produced. Precise samples replace
+// imprecise ones, the window is trimmed to the newest roundTimeWindow rounds.
+// A new slice is always allocated so that copies of the model never share
+// state.
+func (r roundTimes) observe(round uint64, t time.Time, precise bool) roundTimes {
+	samples := make([]roundSample, 0, len(r.samples)+1)
+	found := false
+	for _, s := range r.samples {
+		if s.round == round {
+			found = true
+			if precise || !s.precise {
+				s = roundSample{round: round, time: t, precise: precise}
+			}
+		}
+		samples = append(samples, s)
+	}
+	if !found {
+		samples = append(samples, roundSample{round: round, time: t, precise: precise})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].round < samples[j].round
+	})
+	if len(samples) > roundTimeWindow+1 {
+		samples = samples[len(samples)-roundTimeWindow-1:]
+	}
+	return roundTimes{samples: samples}
+}
+
+// durations returns the time taken by each round in the window, oldest first.
+// Gaps between samples are averaged over the number of missing rounds.
+func (r roundTimes) durations() []time.Duration {
+	var result []time.Duration
+	for i := 1; i < len(r.samples); i++ {
+		prev, cur := r.samples[i-1], r.samples[i]
+		elapsed := cur.time.Sub(prev.time)
+		if elapsed < 0 {
+			continue
+		}
+		result = append(result, elapsed/time.Duration(cur.round-prev.round))
+	}
+	return result
+}
+
+// roundStats summarizes the round durations in the window.
+type roundStats struct {
+	min time.Duration
+	avg time.Duration
+	p95 time.Duration
+}
+
+func (r roundTimes) stats() (roundStats, bool) {
+	durations := r.durations()
+	if len(durations) == 0 {
+		return roundStats{}, false
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	idx := (len(sorted)*95+99)/100 - 1
+	return roundStats{
+		min: sorted[0],
+		avg: total / time.Duration(len(sorted)),
+		p95: sorted[idx],
+	}, true
+}
+
+// sparkline renders the round durations in the window as a sparkline.
+func (r roundTimes) sparkline() string {
+	durations := r.durations()
+	if len(durations) == 0 {
+		return ""
+	}
+
+	lo, hi := durations[0], durations[0]
+	for _, d := range durations {
+		if d < lo {
+			lo = d
+		}
+		if d > hi {
+			hi = d
+		}
+	}
+
+	var b strings.Builder
+	for _, d := range durations {
+		idx := 0
+		if hi > lo {
+			idx = int(float64(d-lo) / float64(hi-lo) * float64(len(sparkTicks)-1))
+		}
+		b.WriteRune(sparkTicks[idx])
+	}
+	return b.String()
+}
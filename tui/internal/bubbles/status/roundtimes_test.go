@@ -0,0 +1,103 @@
+package status
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoundTimesStats(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+
+	tests := []struct {
+		name    string
+		samples []roundSample
+		want    roundStats
+		ok      bool
+	}{
+		{name: "no samples"},
+		{name: "one sample", samples: []roundSample{{round: 1, time: start}}},
+		{
+			name: "even rounds",
+			samples: []roundSample{
+				{round: 1, time: start},
+				{round: 2, time: at(3 * time.Second)},
+				{round: 3, time: at(6 * time.Second)},
+			},
+			want: roundStats{min: 3 * time.Second, avg: 3 * time.Second, p95: 3 * time.Second},
+			ok:   true,
+		},
+		{
+			name: "gap is averaged",
+			samples: []roundSample{
+				{round: 1, time: start},
+				{round: 5, time: at(12 * time.Second)},
+				{round: 6, time: at(17 * time.Second)},
+			},
+			want: roundStats{min: 3 * time.Second, avg: 4 * time.Second, p95: 5 * time.Second},
+			ok:   true,
+		},
+		{
+			name: "clock going back is skipped",
+			samples: []roundSample{
+				{round: 1, time: at(5 * time.Second)},
+				{round: 2, time: start},
+				{round: 3, time: at(4 * time.Second)},
+			},
+			want: roundStats{min: 4 * time.Second, avg: 4 * time.Second, p95: 4 * time.Second},
+			ok:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := roundTimes{samples: tt.samples}.stats()
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("stats() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRoundTimesP95(t *testing.T) {
+	var r roundTimes
+	start := time.Unix(1700000000, 0)
+	elapsed := time.Duration(0)
+	// 19 rounds of 3s and one of 10s, the slow round is above the p95.
+	r = r.observe(0, start, true)
+	for round := uint64(1); round <= 20; round++ {
+		d := 3 * time.Second
+		if round == 20 {
+			d = 10 * time.Second
+		}
+		elapsed += d
+		r = r.observe(round, start.Add(elapsed), true)
+	}
+	stats, ok := r.stats()
+	if !ok || stats.p95 != 3*time.Second {
+		t.Errorf("p95 = %s, want 3s for 19 of 20 rounds at 3s", stats.p95)
+	}
+}
+
+func TestRoundTimesObserve(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	var r roundTimes
+	r = r.observe(2, start.Add(4*time.Second), false)
+	r = r.observe(1, start, false)
+	// A precise status update replaces the header time, it is not replaced by
+	// a later header.
+	r = r.observe(2, start.Add(4500*time.Millisecond), true)
+	r = r.observe(2, start.Add(4*time.Second), false)
+	if len(r.samples) != 2 || r.samples[0].round != 1 {
+		t.Fatalf("samples = %+v, want rounds 1 and 2 in order", r.samples)
+	}
+	if got := r.samples[1].time.Sub(start); got != 4500*time.Millisecond {
+		t.Errorf("round 2 time = %s, want the precise 4.5s", got)
+	}
+
+	for round := uint64(3); round < 100; round++ {
+		r = r.observe(round, start.Add(time.Duration(round)*time.Second), true)
+	}
+	if len(r.samples) != roundTimeWindow+1 {
+		t.Errorf("window has %d samples, want %d", len(r.samples), roundTimeWindow+1)
+	}
+}
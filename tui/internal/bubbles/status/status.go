@@ -21,12 +21,6 @@ import (
 
 const roundTo = time.Second / 10
 
-// consensus constants, in theory these could be modified by a consensus upgrade.
-const (
-	upgradeVoteRounds = 10000
-	upgradeThreshold  = 9000
-)
-
 // Model representing the status.
 type Model struct {
 	Status  models.NodeStatus
@@ -34,28 +28,55 @@ type Model struct {
 	Network messages.NetworkMsg
 	Err     error
 
-	style     *style.Styles
-	requestor *messages.Requestor
+	style      *style.Styles
+	requestor  *messages.Requestor
+	thresholds HealthThresholds
+	consensus  messages.ConsensusTable
+	refresh    time.Duration
+
+	// normal catchup state
+	networkRound uint64
+	sync         syncTracker
 
 	// fast catchup state
-	progress          progress.Model
-	processedAcctsPct float64
-	verifiedAcctsPct  float64
-	acquiredBlksPct   float64
+	progress   progress.Model
+	catchpoint catchpointTracker
 
 	// round time calculation state
 	startBlock  uint64
 	startTime   time.Time
 	latestBlock uint64
 	latestTime  time.Time
+	roundTimes  roundTimes
+}
+
+// defaultRefresh is how often the status is polled when not configured.
+const defaultRefresh = 100 * time.Millisecond
+
+// Options configures the status Model.
+type Options struct {
+	Health    HealthThresholds
+	Consensus messages.ConsensusTable
+	// NetworkRound is the sync target when there is no reference node.
+	NetworkRound uint64
+	// Refresh is how often the status is polled.
+	Refresh time.Duration
 }
 
 // New creates a status Model.
-func New(style *style.Styles, requestor *messages.Requestor) Model {
+func New(style *style.Styles, requestor *messages.Requestor, options Options) Model {
+	refresh := options.Refresh
+	if refresh <= 0 {
+		refresh = defaultRefresh
+	}
 	return Model{
-		style:     style,
-		progress:  progress.New(progress.WithDefaultGradient()),
-		requestor: requestor,
+		style:        style,
+		progress:     progress.New(progress.WithDefaultGradient()),
+		requestor:    requestor,
+		thresholds:   options.Health,
+		consensus:    options.Consensus,
+		networkRound: options.NetworkRound,
+		refresh:      refresh,
 	}
 }
 
@@ -64,6 +85,7 @@ func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.requestor.GetNetworkCmd(),
 		m.requestor.GetStatusCmd(),
+		m.requestor.GetNetworkRoundCmd(),
 	)
 }
 
@@ -84,6 +106,11 @@ func (m Model) averageBlockTime() time.Duration {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case explorer.BlocksMsg:
+		// Seed the round times with block header timestamps.
+		for _, blk := range msg.Blocks {
+			m.roundTimes = m.roundTimes.observe(blk.Round, time.Unix(blk.Block.Block.TimeStamp, 0), false)
+		}
+
 		// Still initializing.
 		if m.Status.LastRound == 0 {
 			return m, nil
@@ -103,36 +130,49 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.Status = msg.Status
 
 		// Save the times for computing round time
+		var blockTime tea.Cmd
 		if m.latestBlock < m.Status.LastRound {
 			since := time.Duration(m.Status.TimeSinceLastRound)
 			m.latestBlock = m.Status.LastRound
 			m.latestTime = time.Now().Add(-since)
+			m.roundTimes = m.roundTimes.observe(m.latestBlock, m.latestTime, true)
 
 			// Grab the start time
 			if m.startBlock == 0 {
 				m.startBlock = m.Status.LastRound
 				m.startTime = m.latestTime
 			}
-		}
 
-		if m.Status.CatchpointTotalAccounts > 0 {
-			m.processedAcctsPct = float64(m.Status.CatchpointProcessedAccounts) / float64(m.Status.CatchpointTotalAccounts)
-			m.verifiedAcctsPct = float64(m.Status.CatchpointVerifiedAccounts) / float64(m.Status.CatchpointTotalAccounts)
+			// Share the round timing with the other bubbles, e.g. for key expiry estimates.
+			timing := messages.BlockTimeMsg{Round: m.latestBlock, Average: m.averageBlockTime()}
+			blockTime = func() tea.Msg { return timing }
 		}
-		if m.Status.CatchpointTotalBlocks > 0 {
-			m.processedAcctsPct = 1
-			m.verifiedAcctsPct = 1
-			m.acquiredBlksPct = float64(m.Status.CatchpointAcquiredBlocks) / float64(m.Status.CatchpointTotalBlocks)
+
+		if m.Status.CatchupTime > 0 {
+			m.sync = m.sync.observe(m.Status.LastRound, time.Now())
+		} else {
+			m.sync = syncTracker{}
 		}
 
-		return m, tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
+		m.catchpoint = m.catchpoint.observe(m.Status, time.Now())
+
+		return m, tea.Batch(blockTime, tea.Tick(m.refresh, func(time.Time) tea.Msg {
 			return m.requestor.GetStatusCmd()()
-		})
+		}))
 
 	case messages.NetworkMsg:
 		m.Network = msg
 		return m, nil
 
+	case messages.NetworkRoundMsg:
+		// Keep the last known round if the reference node is unavailable.
+		if msg.Err == nil {
+			m.networkRound = msg.Round
+		}
+		return m, tea.Tick(5*time.Second, func(time.Time) tea.Msg {
+			return m.requestor.GetNetworkRoundCmd()()
+		})
+
 	case progress.FrameMsg:
 		progressModel, cmd := m.progress.Update(msg)
 		m.progress = progressModel.(progress.Model)
@@ -157,7 +197,11 @@ func writeProgress(b *strings.Builder, prefix string, progress progress.Model, p
 	b.WriteString("\n")
 }
 
+// calculateTimeToGo renders the rounds and estimated time from start to end.
 func (m Model) calculateTimeToGo(start, end uint64, style lipgloss.Style) string {
+	if end <= start {
+		return style.Render("reached")
+	}
 	rounds := end - start
 	timeRemaining := time.Duration(int64(rounds) * m.averageBlockTime().Nanoseconds()).Round(roundTo)
 	return style.Render(fmt.Sprintf("%d to go, %s", rounds, timeRemaining))
@@ -173,56 +217,62 @@ func (m Model) View() string {
 	builder.WriteString(fmt.Sprintf("%s %s\n", bold.Render("Genesis:"), base64.StdEncoding.EncodeToString(m.Network.GenesisHash[:])))
 	// TODO: get rid of magic number
 	height := style.TopHeight - 2 - 3 // 3 is the padding/margin/border
+	borderColor := m.style.InactiveBorderColor
 	// status
 	if (m.Status != models.NodeStatus{}) {
+		h := evaluateHealth(m.Status, m.networkRound, m.thresholds)
+		if h.level != healthy {
+			borderColor = m.borderColor(h.level)
+			builder.WriteString(fmt.Sprintf("%s %s\n", bold.Render("Health:"), m.textStyle(h.level).Render(h.reason)))
+			height--
+		}
+
 		switch {
+		case m.Status.StoppedAtUnsupportedRound:
+			// The node cannot apply the next block until algod is upgraded.
+			critical := m.style.StatusCriticalText
+			builder.WriteString(fmt.Sprintf("%s\n", critical.Render(fmt.Sprintf("NODE STOPPED AT ROUND %d", m.Status.LastRound))))
+			builder.WriteString(fmt.Sprintf("Current Protocol: %s\n", formatVersion(m.Status.LastVersion)))
+			builder.WriteString(fmt.Sprintf("Next Protocol:    %s %s\n", formatVersion(m.Status.NextVersion), critical.Render("(not supported)")))
+			builder.WriteString(fmt.Sprintf("Node version:     %s\n", m.Network.NodeVersion))
+			builder.WriteString(fmt.Sprintf("%s\n", critical.Render("Upgrade algod to continue following the network.")))
+			height -= 5
 		case m.Status.Catchpoint != "":
 			// Catchpoint view
-			builder.WriteString(fmt.Sprintf("\n    Catchpoint: %s\n", key.Render(strings.Split(m.Status.Catchpoint, "#")[0])))
-			var catchupStatus string
-			switch {
-			case m.Status.CatchpointAcquiredBlocks > 0:
-				catchupStatus = fmt.Sprintf("    Downloading blocks:   %5d / %d\n", m.Status.CatchpointAcquiredBlocks, m.Status.CatchpointTotalBlocks)
-			case m.Status.CatchpointVerifiedAccounts > 0:
-				catchupStatus = fmt.Sprintf("    Processing accounts:   %d / %d\n", m.Status.CatchpointVerifiedAccounts, m.Status.CatchpointTotalAccounts)
-			case m.Status.CatchpointProcessedAccounts > 0:
-				catchupStatus = fmt.Sprintf("    Downloading accounts: %d / %d\n", m.Status.CatchpointProcessedAccounts, m.Status.CatchpointTotalAccounts)
-			default:
-				catchupStatus = "\n"
-			}
-			builder.WriteString(bold.Render(catchupStatus))
-			builder.WriteString("\n")
-			writeProgress(&builder, "Downloading accounts: ", m.progress, m.processedAcctsPct)
-			writeProgress(&builder, "Processing accounts:  ", m.progress, m.verifiedAcctsPct)
-			writeProgress(&builder, "Downloading blocks:   ", m.progress, m.acquiredBlksPct)
-			height -= 7
+			builder.WriteString(m.catchpointView())
+			height -= 2 + int(numPhases)
 		default:
 			builder.WriteString(fmt.Sprintf("Current round:   %s\n", key.Render(strconv.FormatUint(m.Status.LastRound, 10))))
 			builder.WriteString(fmt.Sprintf("Block wait time: %s\n", time.Duration(m.Status.TimeSinceLastRound).Round(roundTo)))
 			builder.WriteString(fmt.Sprintf("Sync time:       %s\n", time.Duration(m.Status.CatchupTime).Round(roundTo)))
 			height -= 3
+			if sync, ok := m.syncProgress(); ok {
+				// round times are not meaningful while catching up.
+				builder.WriteString(fmt.Sprintf("Rounds behind:   %s\n", m.style.AccountBlueText.Render(sync.String())))
+				writeProgress(&builder, "Sync progress:   ", m.progress, sync.pct)
+				height -= 2
+			} else if stats, ok := m.roundTimes.stats(); ok {
+				builder.WriteString(fmt.Sprintf("Round times:     %s\n", m.style.AccountBlueText.Render(m.roundTimes.sparkline())))
+				builder.WriteString(fmt.Sprintf("                 min %s, avg %s, p95 %s\n",
+					stats.min.Round(roundTo), stats.avg.Round(roundTo), stats.p95.Round(roundTo)))
+				height -= 2
+			}
 			if m.Header.UpgradeState != (types.UpgradeState{}) && (uint64(m.Header.UpgradeState.NextProtocolVoteBefore) > m.Status.LastRound) {
-				//remainingToUpgrade := m.calculateTimeToGo(
-				//	m.Status.LastRound, uint64(m.Header.NextProtocolSwitchOn), m.style.AccountBlueText)
+				vote := m.upgradeVote()
+				switchOn := m.switchOn()
 				remainingToVote := m.calculateTimeToGo(
 					m.Status.LastRound, uint64(m.Header.NextProtocolVoteBefore), m.style.AccountBlueText)
+				remainingToUpgrade := m.calculateTimeToGo(
+					m.Status.LastRound, switchOn, m.style.AccountBlueText)
 
-				// calculate yes/no votes
-				votesToGo := uint64(m.Header.NextProtocolVoteBefore) - m.Status.LastRound
-				votes := upgradeVoteRounds - votesToGo
-				voteYes := m.Header.NextProtocolApprovals
-				voteNo := votes - voteYes
-				voteString := fmt.Sprintf("%d / %d", voteYes, voteNo)
-				yesPct := float64(voteYes) / float64(votes)
-				windowPct := float64(votes) / float64(upgradeVoteRounds)
 				builder.WriteString(fmt.Sprintf("%s\n", bold.Render("Consensus Upgrade Pending: Votes")))
 				builder.WriteString(fmt.Sprintf("Next Protocol:     %s\n", formatVersion(m.Header.NextProtocol)))
-				builder.WriteString(fmt.Sprintf("Yes/No votes:      %s (%.0f%%, 90%% required)\n", voteString, yesPct*100))
-				//builder.WriteString(fmt.Sprintf("Vote window:      %s (%f%%)\n", voteString, *100))
+				builder.WriteString(fmt.Sprintf("Yes/No votes:      %s\n", m.voteSummary(vote)))
 				builder.WriteString(fmt.Sprintf("Vote window close: %d (%.0f%%, %s)\n",
 					m.Header.UpgradeState.NextProtocolVoteBefore,
-					windowPct*100,
+					vote.windowPct(),
 					remainingToVote))
+				builder.WriteString(fmt.Sprintf("Switch on round:   %d (%s)\n", switchOn, remainingToUpgrade))
 
 				height -= 5
 			} else if m.Status.LastVersion == m.Status.NextVersion {
@@ -231,11 +281,8 @@ func (m Model) View() string {
 				builder.WriteString(fmt.Sprintf("                 %s\n", bold.Render("No upgrade in progress.")))
 				height -= 2
 			} else {
-				// compute the time until the upgrade round and apply formatting to message
-				togo := m.Status.NextVersionRound - m.Status.LastRound
-				timeRemaining := time.Duration(int64(togo) * m.averageBlockTime().Nanoseconds()).Round(roundTo)
-				remaining := m.style.AccountBlueText.Render(
-					fmt.Sprintf("%d to go, %s", togo, timeRemaining))
+				remaining := m.calculateTimeToGo(
+					m.Status.LastRound, m.Status.NextVersionRound, m.style.AccountBlueText)
 
 				// upgrade in progress
 				builder.WriteString(fmt.Sprintf("%s\n", bold.Render("Consensus Upgrade Scheduled")))
@@ -243,6 +290,12 @@ func (m Model) View() string {
 				builder.WriteString(fmt.Sprintf("Next Protocol:    %s\n", formatVersion(m.Status.NextVersion)))
 				builder.WriteString(fmt.Sprintf("Upgrade round:    %d (%s)\n", m.Status.NextVersionRound, remaining))
 				height -= 4
+				if !m.Status.NextVersionSupported {
+					// warn before the node stops at the upgrade round.
+					builder.WriteString(fmt.Sprintf("%s\n", m.style.StatusWarningText.Render(
+						"Next protocol is not supported by this node, upgrade algod!")))
+					height--
+				}
 			}
 		}
 	}
@@ -253,5 +306,5 @@ func (m Model) View() string {
 		height--
 	}
 
-	return m.style.Status.Render(builder.String())
+	return m.style.Status.Copy().BorderForeground(borderColor).Render(builder.String())
 }
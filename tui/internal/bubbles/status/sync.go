@@ -0,0 +1,63 @@
+package status
+
+import (
+	"fmt"
+	"time"
+)
+
+// syncTracker measures catchup speed over a rolling window.
+type syncTracker struct {
+	// startRound is the first round seen while catching up, used for the progress bar.
+	startRound uint64
+	rounds     rateTracker
+}
+
+// observe records the round reached at a time.
+func (s syncTracker) observe(round uint64, t time.Time) syncTracker {
+	if s.startRound == 0 {
+		s.startRound = round
+	}
+	s.rounds = s.rounds.observe(round, t)
+	return s
+}
+
+// rate returns the catchup speed in rounds per second.
+func (s syncTracker) rate() float64 {
+	return s.rounds.rate()
+}
+
+// syncProgress describes how far the node is from the network round.
+type syncProgress struct {
+	behind uint64
+	rate   float64
+	eta    time.Duration
+	pct    float64
+}
+
+// syncProgress compares the node against the network round. The second
+// return value is false when the network round is unknown or the node is
+// not behind.
+func (m Model) syncProgress() (syncProgress, bool) {
+	if m.networkRound == 0 || m.Status.CatchupTime == 0 || m.Status.LastRound >= m.networkRound {
+		return syncProgress{}, false
+	}
+
+	result := syncProgress{
+		behind: m.networkRound - m.Status.LastRound,
+		rate:   m.sync.rate(),
+	}
+	if result.rate > 0 {
+		result.eta = time.Duration(float64(result.behind) / result.rate * float64(time.Second))
+	}
+	if total := m.networkRound - m.sync.startRound; m.sync.startRound != 0 && total > 0 {
+		result.pct = float64(m.Status.LastRound-m.sync.startRound) / float64(total)
+	}
+	return result, true
+}
+
+func (p syncProgress) String() string {
+	if p.rate == 0 {
+		return fmt.Sprintf("%d (measuring speed)", p.behind)
+	}
+	return fmt.Sprintf("%d (%.1f rounds/s, %s to go)", p.behind, p.rate, p.eta.Round(time.Second))
+}
@@ -0,0 +1,97 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+)
+
+func TestSyncTracker(t *testing.T) {
+	now := time.Now()
+	s := syncTracker{}.observe(1000, now)
+	if s.rate() != 0 {
+		t.Errorf("rate = %v, want 0 with a single sample", s.rate())
+	}
+
+	s = s.observe(1100, now.Add(10*time.Second))
+	if s.startRound != 1000 {
+		t.Errorf("startRound = %d, want 1000", s.startRound)
+	}
+	if got := s.rate(); got != 10 {
+		t.Errorf("rate = %v, want 10 rounds/s", got)
+	}
+
+	// Samples older than the window are dropped.
+	s = s.observe(1400, now.Add(rateWindow+10*time.Second))
+	if got := s.rate(); got != 300.0/rateWindow.Seconds() {
+		t.Errorf("rate = %v, want it measured from the samples in the window", got)
+	}
+	if s.startRound != 1000 {
+		t.Errorf("startRound = %d, want the first round kept", s.startRound)
+	}
+}
+
+func TestSyncProgress(t *testing.T) {
+	now := time.Now()
+	tracker := syncTracker{}.observe(1000, now).observe(1100, now.Add(10*time.Second))
+	catchingUp := models.NodeStatus{LastRound: 1100, CatchupTime: uint64(time.Minute)}
+
+	tests := []struct {
+		name         string
+		status       models.NodeStatus
+		networkRound uint64
+		tracker      syncTracker
+		ok           bool
+		want         syncProgress
+		text         string
+	}{
+		{
+			name:    "unknown network round",
+			status:  catchingUp,
+			tracker: tracker,
+		},
+		{
+			name:         "not catching up",
+			status:       models.NodeStatus{LastRound: 1100},
+			networkRound: 2000,
+			tracker:      tracker,
+		},
+		{
+			name:         "caught up",
+			status:       models.NodeStatus{LastRound: 2000, CatchupTime: uint64(time.Minute)},
+			networkRound: 2000,
+			tracker:      tracker,
+		},
+		{
+			name:         "behind",
+			status:       catchingUp,
+			networkRound: 2000,
+			tracker:      tracker,
+			ok:           true,
+			want:         syncProgress{behind: 900, rate: 10, eta: 90 * time.Second, pct: 0.1},
+			text:         "900 (10.0 rounds/s, 1m30s to go)",
+		},
+		{
+			name:         "measuring speed",
+			status:       catchingUp,
+			networkRound: 2000,
+			tracker:      syncTracker{}.observe(1100, now),
+			ok:           true,
+			want:         syncProgress{behind: 900},
+			text:         "900 (measuring speed)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{Status: tt.status, networkRound: tt.networkRound, sync: tt.tracker}
+			got, ok := m.syncProgress()
+			if ok != tt.ok || got != tt.want {
+				t.Fatalf("syncProgress() = %+v %v, want %+v %v", got, ok, tt.want, tt.ok)
+			}
+			if ok && got.String() != tt.text {
+				t.Errorf("String() = %q, want %q", got.String(), tt.text)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/algorand/node-ui/messages"
+)
+
+// upgradeVote is the tally of an ongoing consensus upgrade vote.
+type upgradeVote struct {
+	yes       uint64
+	no        uint64
+	remaining uint64
+	required  uint64
+	rounds    uint64
+}
+
+func (m Model) consensusParams() messages.ConsensusParams {
+	protocol := m.Header.CurrentProtocol
+	if protocol == "" {
+		protocol = m.Status.LastVersion
+	}
+	return m.consensus.Params(protocol)
+}
+
+// upgradeVote computes the vote tally. Newer versions of algod report the
+// tally in the status response, otherwise it is derived from the block
+// header and the consensus parameters of the current protocol.
+func (m Model) upgradeVote() upgradeVote {
+	if m.Status.UpgradeVoteRounds > 0 {
+		cast := m.Status.UpgradeYesVotes + m.Status.UpgradeNoVotes
+		var remaining uint64
+		if cast < m.Status.UpgradeVoteRounds {
+			remaining = m.Status.UpgradeVoteRounds - cast
+		}
+		return upgradeVote{
+			yes:       m.Status.UpgradeYesVotes,
+			no:        m.Status.UpgradeNoVotes,
+			remaining: remaining,
+			required:  m.Status.UpgradeVotesRequired,
+			rounds:    m.Status.UpgradeVoteRounds,
+		}
+	}
+
+	params := m.consensusParams()
+	var remaining uint64
+	if voteBefore := uint64(m.Header.NextProtocolVoteBefore); voteBefore > m.Status.LastRound {
+		remaining = voteBefore - m.Status.LastRound
+	}
+	if remaining > params.UpgradeVoteRounds {
+		remaining = params.UpgradeVoteRounds
+	}
+	cast := params.UpgradeVoteRounds - remaining
+	yes := m.Header.NextProtocolApprovals
+	if yes > cast {
+		yes = cast
+	}
+	return upgradeVote{
+		yes:       yes,
+		no:        cast - yes,
+		remaining: remaining,
+		required:  params.UpgradeThreshold,
+		rounds:    params.UpgradeVoteRounds,
+	}
+}
+
+// yesPct is the percentage of cast votes in favor of the upgrade.
+func (v upgradeVote) yesPct() float64 {
+	if v.yes+v.no == 0 {
+		return 0
+	}
+	return float64(v.yes) / float64(v.yes+v.no) * 100
+}
+
+// requiredPct is the percentage of the vote window required to pass.
+func (v upgradeVote) requiredPct() float64 {
+	if v.rounds == 0 {
+		return 0
+	}
+	return float64(v.required) / float64(v.rounds) * 100
+}
+
+// windowPct is the percentage of the vote window which has elapsed.
+func (v upgradeVote) windowPct() float64 {
+	if v.rounds == 0 {
+		return 0
+	}
+	return float64(v.yes+v.no) / float64(v.rounds) * 100
+}
+
+// projection describes the expected outcome, remaining votes are assumed to
+// follow the current yes/no ratio.
+func (v upgradeVote) projection() (string, bool) {
+	switch {
+	case v.yes >= v.required:
+		return "will pass", true
+	case v.yes+v.remaining < v.required:
+		return "will fail", false
+	case v.yes+v.no == 0:
+		return "no votes yet", true
+	}
+	projected := v.yes + v.remaining*v.yes/(v.yes+v.no)
+	if projected >= v.required {
+		return "likely pass", true
+	}
+	return "likely fail", false
+}
+
+// switchOn returns the round the new protocol takes effect if the vote
+// passes. It is estimated with the default delay if the header is missing it.
+func (m Model) switchOn() uint64 {
+	if m.Header.NextProtocolSwitchOn != 0 {
+		return uint64(m.Header.NextProtocolSwitchOn)
+	}
+	return uint64(m.Header.NextProtocolVoteBefore) + m.consensusParams().DefaultUpgradeWaitRounds
+}
+
+func (m Model) voteSummary(v upgradeVote) string {
+	outcome, pass := v.projection()
+	style := m.style.AccountBlueText
+	if !pass {
+		style = m.style.StatusWarningText
+	}
+	return fmt.Sprintf("%d / %d (%.0f%%, need %.0f%%, %s)",
+		v.yes, v.no, v.yesPct(), v.requiredPct(), style.Render(outcome))
+}
@@ -0,0 +1,100 @@
+package status
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+
+	"github.com/algorand/node-ui/tui/internal/style"
+)
+
+func TestProjection(t *testing.T) {
+	tests := []struct {
+		name     string
+		vote     upgradeVote
+		want     string
+		wantPass bool
+	}{
+		{name: "passed", vote: upgradeVote{yes: 9000, no: 500, remaining: 500, required: 9000}, want: "will pass", wantPass: true},
+		{name: "can not pass", vote: upgradeVote{yes: 5000, no: 4000, remaining: 1000, required: 9000}, want: "will fail"},
+		{name: "no votes", vote: upgradeVote{remaining: 10000, required: 9000}, want: "no votes yet", wantPass: true},
+		{name: "all yes", vote: upgradeVote{yes: 100, remaining: 9900, required: 9000}, want: "likely pass", wantPass: true},
+		{name: "too many no", vote: upgradeVote{yes: 80, no: 20, remaining: 9900, required: 9000}, want: "likely fail"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, pass := tt.vote.projection()
+			if got != tt.want || pass != tt.wantPass {
+				t.Errorf("projection() = %s, %v, want %s, %v", got, pass, tt.want, tt.wantPass)
+			}
+		})
+	}
+}
+
+func TestSwitchOn(t *testing.T) {
+	m := New(style.DefaultStyles(), nil, Options{})
+	m.Header.CurrentProtocol = "v10"
+	m.Header.NextProtocolVoteBefore = 1000
+	if got := m.switchOn(); got != 11000 {
+		t.Errorf("switchOn() = %d, want 11000 for v10", got)
+	}
+
+	m.Header.CurrentProtocol = "future"
+	if got := m.switchOn(); got != 141000 {
+		t.Errorf("switchOn() = %d, want 141000", got)
+	}
+
+	m.Header.NextProtocolSwitchOn = 2000
+	if got := m.switchOn(); got != 2000 {
+		t.Errorf("switchOn() = %d, want the header value 2000", got)
+	}
+}
+
+// TestViewHeight checks the status box keeps its height when the most lines
+// are shown: a health warning, sync progress and an upgrade vote.
+func TestViewHeight(t *testing.T) {
+	m := New(style.DefaultStyles(), nil, Options{
+		Health:       HealthThresholds{Catchup: time.Minute},
+		NetworkRound: 2000,
+	})
+	m.Status = models.NodeStatus{LastRound: 1000, LastVersion: "future", NextVersion: "future"}
+	want := strings.Count(m.View(), "\n")
+
+	m.Status.CatchupTime = uint64(time.Hour)
+	m.Header.UpgradeState = types.UpgradeState{
+		CurrentProtocol:        "future",
+		NextProtocol:           "next",
+		NextProtocolApprovals:  400,
+		NextProtocolVoteBefore: 1500,
+	}
+	view := m.View()
+	if !strings.Contains(view, "Catching up") || !strings.Contains(view, "Rounds behind") || !strings.Contains(view, "Switch on round") {
+		t.Fatalf("expected the health warning, sync progress and upgrade vote:\n%s", view)
+	}
+	if got := strings.Count(view, "\n"); got != want {
+		t.Errorf("status box has %d lines, want %d:\n%s", got+1, want+1, view)
+	}
+}
+
+func TestScheduledUpgradeRemaining(t *testing.T) {
+	m := New(style.DefaultStyles(), nil, Options{})
+	m.Status = models.NodeStatus{
+		LastRound:            1000,
+		LastVersion:          "v1",
+		NextVersion:          "v2",
+		NextVersionRound:     1100,
+		NextVersionSupported: true,
+	}
+	if view := m.View(); !strings.Contains(view, "1100 (100 to go") {
+		t.Errorf("expected 100 rounds to go:\n%s", view)
+	}
+
+	m.Status.LastRound = 1200
+	view := m.View()
+	if !strings.Contains(view, "1100 (reached)") || strings.Contains(view, "to go") {
+		t.Errorf("expected the upgrade round to be reached:\n%s", view)
+	}
+}
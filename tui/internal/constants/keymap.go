@@ -12,6 +12,31 @@ type KeyMap struct {
 	Forward      key.Binding
 	Back         key.Binding
 	Help         key.Binding
+
+	// Accounts tab
+	Up            key.Binding
+	Down          key.Binding
+	AddAccount    key.Binding
+	RemoveAccount key.Binding
+	MoveUp        key.Binding
+	MoveDown      key.Binding
+
+	// Participation tab
+	InstallKey  key.Binding
+	GenerateKey key.Binding
+	DeleteKey   key.Binding
+
+	// Configuration tab
+	Filter           key.Binding
+	ToggleOverridden key.Binding
+	EditSetting      key.Binding
+	DataFiles        key.Binding
+
+	// Logs tab
+	LogLevel  key.Binding
+	PauseLog  key.Binding
+	FollowLog key.Binding
+	AuditLog  key.Binding
 }
 
 // ShortHelp implements the KeyMap interface.
@@ -21,7 +46,22 @@ func (k KeyMap) ShortHelp() []key.Binding {
 
 // FullHelp implements the KeyMap interface.
 func (k KeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{k.ShortHelp()}
+	return [][]key.Binding{
+		k.ShortHelp(),
+		{k.AddAccount, k.RemoveAccount, k.MoveUp, k.MoveDown},
+		{k.InstallKey, k.GenerateKey, k.DeleteKey},
+		{k.Filter, k.ToggleOverridden, k.EditSetting, k.DataFiles},
+		{k.LogLevel, k.PauseLog, k.FollowLog, k.AuditLog},
+	}
+}
+
+// ViewerKeys returns the key map with the bindings which operate the node
+// disabled, disabled bindings are left out of the help.
+func (k KeyMap) ViewerKeys() KeyMap {
+	for _, b := range []*key.Binding{&k.Catchup, &k.AbortCatchup, &k.InstallKey, &k.GenerateKey, &k.DeleteKey, &k.EditSetting} {
+		b.SetEnabled(false)
+	}
+	return k
 }
 
 // Keys is a global for accessing the KeyMap.
@@ -49,4 +89,55 @@ var Keys = KeyMap{
 	Back: key.NewBinding(
 		key.WithKeys("esc", "←"),
 		key.WithHelp("esc", "backwards")),
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up")),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down")),
+	AddAccount: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "add account")),
+	RemoveAccount: key.NewBinding(
+		key.WithKeys("x", "delete"),
+		key.WithHelp("x", "remove account")),
+	MoveUp: key.NewBinding(
+		key.WithKeys("K", "shift+up"),
+		key.WithHelp("K", "move account up")),
+	MoveDown: key.NewBinding(
+		key.WithKeys("J", "shift+down"),
+		key.WithHelp("J", "move account down")),
+	InstallKey: key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "install key file")),
+	GenerateKey: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "generate key")),
+	DeleteKey: key.NewBinding(
+		key.WithKeys("x", "delete"),
+		key.WithHelp("x", "delete key")),
+	Filter: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter settings")),
+	ToggleOverridden: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "overridden only")),
+	EditSetting: key.NewBinding(
+		key.WithKeys("e", "enter"),
+		key.WithHelp("e", "edit setting")),
+	DataFiles: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "data files")),
+	LogLevel: key.NewBinding(
+		key.WithKeys("l"),
+		key.WithHelp("l", "minimum level")),
+	PauseLog: key.NewBinding(
+		key.WithKeys("p", " "),
+		key.WithHelp("p", "pause")),
+	FollowLog: key.NewBinding(
+		key.WithKeys("G", "end"),
+		key.WithHelp("G", "follow")),
+	AuditLog: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "audit log")),
 }
@@ -0,0 +1,47 @@
+// Package layout has the viewport title and footer bars shared by the tabs.
+package layout
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// TitleStyle frames the title at the start of a viewport header.
+	TitleStyle = func() lipgloss.Style {
+		b := lipgloss.RoundedBorder()
+		b.Right = "├"
+		return lipgloss.NewStyle().BorderStyle(b).Padding(0, 1)
+	}()
+
+	// InfoStyle frames the scroll position at the end of a viewport footer.
+	InfoStyle = func() lipgloss.Style {
+		b := lipgloss.RoundedBorder()
+		b.Left = "┤"
+		return TitleStyle.Copy().BorderStyle(b)
+	}()
+)
+
+// Header renders the title followed by a line to the end of the width.
+func Header(title string, width int) string {
+	title = TitleStyle.Render(title)
+	line := strings.Repeat("─", Max(0, width-lipgloss.Width(title)))
+	return lipgloss.JoinHorizontal(lipgloss.Center, title, line)
+}
+
+// Footer renders a line followed by the scroll percentage of a viewport.
+func Footer(scrollPercent float64, width int) string {
+	info := InfoStyle.Render(fmt.Sprintf("%3.f%%", scrollPercent*100))
+	line := strings.Repeat("─", Max(0, width-lipgloss.Width(info)))
+	return lipgloss.JoinHorizontal(lipgloss.Center, line, info)
+}
+
+// Max returns the larger of a and b.
+func Max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
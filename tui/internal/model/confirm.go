@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
+)
+
+const (
+	catchupStart = "start"
+	catchupAbort = "abort"
+)
+
+// confirmRequest adds the network to a confirmation. Mainnet actions are
+// confirmed by typing the genesis ID, other networks with y.
+func (m Model) confirmRequest(request confirm.Request) confirm.Request {
+	if request.Confirm == nil {
+		return request
+	}
+	genesisID := m.network.GenesisID
+	if genesisID == "" {
+		genesisID = "unknown"
+	}
+	request.Details = append([]confirm.Detail{{Label: "Network", Value: genesisID}}, request.Details...)
+	if networkFromID(genesisID) == "mainnet" {
+		request.Typed = genesisID
+	}
+	return request
+}
+
+// catchupRequest describes starting or aborting a fast catchup.
+func (m Model) catchupRequest(action, network, catchpoint string) confirm.Request {
+	if action == catchupAbort {
+		return confirm.Request{
+			Title:       "Abort fast catchup",
+			Description: "The node stops catching up to the catchpoint and resumes a regular catchup from its current ledger.",
+			Details:     []confirm.Detail{{Label: "Catchpoint", Value: catchpoint}},
+			Confirm:     m.requestor.StopFastCatchupCmd(network, catchpoint),
+		}
+	}
+	return confirm.Request{
+		Title: "Start fast catchup",
+		Description: "The node replaces its ledger with the state at the catchpoint. " +
+			"On an archival node the block history before the catchpoint is lost.",
+		Details: []confirm.Detail{{Label: "Catchpoint", Value: catchpoint}},
+		Confirm: m.requestor.StartFastCatchupCmd(network, catchpoint),
+	}
+}
+
+// askCatchup confirms a fast catchup action. An abort uses the catchpoint in
+// progress, otherwise the latest catchpoint is looked up first.
+func (m Model) askCatchup(action string) (Model, tea.Cmd) {
+	network := networkFromID(m.network.GenesisID)
+	if action == catchupAbort && m.catchpoint != "" {
+		return m, confirm.Ask(m.catchupRequest(action, network, m.catchpoint))
+	}
+	m.catchupAction = action
+	return m, messages.GetLatestCatchpointCmd(network)
+}
+
+// updateCatchup handles the catchpoint lookup and the catchup result.
+func (m Model) updateCatchup(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case messages.CatchpointMsg:
+		action := m.catchupAction
+		m.catchupAction = ""
+		if action == "" {
+			return m, nil
+		}
+		if msg.Err != nil {
+			return m, confirm.Ask(confirm.Request{
+				Title:       "Fast catchup",
+				Description: fmt.Sprintf("Unable to find the latest catchpoint for '%s': %s", msg.Network, msg.Err),
+			})
+		}
+		return m, confirm.Ask(m.catchupRequest(action, msg.Network, msg.Catchpoint))
+
+	case messages.FastCatchupMsg:
+		if msg.Err != nil {
+			return m, confirm.Ask(confirm.Request{
+				Title:       "Fast catchup",
+				Description: fmt.Sprintf("Unable to %s the fast catchup: %s", msg.Action, msg.Err),
+			})
+		}
+	}
+	return m, nil
+}
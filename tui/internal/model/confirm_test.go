@@ -0,0 +1,46 @@
+package model
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
+)
+
+func TestConfirmRequest(t *testing.T) {
+	action := func() tea.Msg { return nil }
+	tests := []struct {
+		genesisID string
+		confirm   tea.Cmd
+		network   string
+		typed     string
+	}{
+		{genesisID: "mainnet-v1.0", confirm: action, network: "mainnet-v1.0", typed: "mainnet-v1.0"},
+		{genesisID: "testnet-v1.0", confirm: action, network: "testnet-v1.0"},
+		{genesisID: "", confirm: action, network: "unknown"},
+		// Messages without an action are shown as they are.
+		{genesisID: "mainnet-v1.0"},
+	}
+	for _, tt := range tests {
+		m := Model{network: messages.NetworkMsg{GenesisID: tt.genesisID}}
+		request := m.confirmRequest(confirm.Request{
+			Title:   "Delete participation key",
+			Details: []confirm.Detail{{Label: "Key", Value: "ABC"}},
+			Confirm: tt.confirm,
+		})
+		if request.Typed != tt.typed {
+			t.Errorf("%q: Typed = %q, want %q", tt.genesisID, request.Typed, tt.typed)
+		}
+		if tt.network == "" {
+			if len(request.Details) != 1 {
+				t.Errorf("%q: details = %v, want them unchanged", tt.genesisID, request.Details)
+			}
+			continue
+		}
+		if len(request.Details) != 2 || request.Details[0] != (confirm.Detail{Label: "Network", Value: tt.network}) {
+			t.Errorf("%q: details = %v, want the network %s first", tt.genesisID, request.Details, tt.network)
+		}
+	}
+}
@@ -10,8 +10,11 @@ func (m Model) Init() tea.Cmd {
 		tea.EnterAltScreen,
 		m.Status.Init(),
 		m.Accounts.Init(),
+		m.Participation.Init(),
 		m.BlockExplorer.Init(),
 		m.Configs.Init(),
+		m.Logs.Init(),
+		m.Sessions.Init(),
 		m.Tabs.Init(),
 		m.About.Init(),
 		m.Utilities.Init(),
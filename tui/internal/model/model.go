@@ -1,19 +1,29 @@
 package model
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/charmbracelet/bubbles/help"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/algorand/go-algorand-sdk/v2/types"
 
+	"github.com/algorand/node-ui/config"
 	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/alerts"
 	"github.com/algorand/node-ui/tui/internal/bubbles/about"
 	"github.com/algorand/node-ui/tui/internal/bubbles/accounts"
 	"github.com/algorand/node-ui/tui/internal/bubbles/configs"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
 	"github.com/algorand/node-ui/tui/internal/bubbles/explorer"
 	"github.com/algorand/node-ui/tui/internal/bubbles/footer"
+	"github.com/algorand/node-ui/tui/internal/bubbles/logs"
+	"github.com/algorand/node-ui/tui/internal/bubbles/participation"
+	"github.com/algorand/node-ui/tui/internal/bubbles/sessions"
 	"github.com/algorand/node-ui/tui/internal/bubbles/status"
 	"github.com/algorand/node-ui/tui/internal/bubbles/tabs"
+	"github.com/algorand/node-ui/tui/internal/constants"
 	"github.com/algorand/node-ui/tui/internal/style"
 )
 
@@ -28,37 +38,115 @@ const (
 	explorerTab activeComponent = iota
 	utilitiesTab
 	accountTab
+	participationTab
 	configTab
+	logsTab
+	sessionsTab
 	helpTab
+	numTabs
 )
 
+var tabNames = [numTabs]string{
+	explorerTab:      "EXPLORER",
+	utilitiesTab:     "UTILITIES",
+	accountTab:       "ACCOUNTS",
+	participationTab: "PARTICIPATION",
+	configTab:        "CONFIGURATION",
+	logsTab:          "LOGS",
+	sessionsTab:      "SESSIONS",
+	helpTab:          "HELP",
+}
+
+// tabFromName finds a tab by its case-insensitive name, empty selects the explorer.
+func tabFromName(name string) (activeComponent, error) {
+	if name == "" {
+		return explorerTab, nil
+	}
+	for i, tabName := range tabNames {
+		if strings.EqualFold(name, tabName) {
+			return activeComponent(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown tab '%s'", name)
+}
+
 // Model represents the top level of the TUI.
 type Model struct {
 	Status        tea.Model
 	Accounts      tea.Model
+	Participation tea.Model
 	Tabs          tabs.Model
 	BlockExplorer tea.Model
 	Configs       tea.Model
+	Logs          tea.Model
+	Sessions      tea.Model
 	Utilities     tea.Model
 	About         tea.Model
 	Help          help.Model
 	Footer        tea.Model
+	// Confirm is drawn over the active tab while an action is confirmed.
+	Confirm confirm.Model
 
 	network messages.NetworkMsg
+	// catchpoint is the fast catchup in progress, catchupAction is the
+	// action waiting for the latest catchpoint.
+	catchpoint    string
+	catchupAction string
 
 	styles *style.Styles
 
 	requestor *messages.Requestor
 
+	// audit records the actions taken in the session by user, fingerprint is
+	// the SSH key of the user.
+	audit       *messages.AuditLog
+	user        string
+	fingerprint string
+
+	// viewer disables the bindings which operate the node, viewerUtilities
+	// replaces Utilities for viewers.
+	viewer          bool
+	viewerUtilities tea.Model
+
 	active activeComponent
 	// remember the last resize so we can re-send it when selecting a different bottom component.
 	lastResize tea.WindowSizeMsg
 }
 
+// Options configures optional behavior of the TUI.
+type Options struct {
+	Health    status.HealthThresholds
+	Consensus messages.ConsensusTable
+	// NetworkRound is the sync target when there is no reference node.
+	NetworkRound uint64
+	// Config holds the persisted settings, it must not be nil.
+	Config *config.Config
+	// AddressBook labels accounts, it is optional.
+	AddressBook *config.AddressBook
+	// AllowConfigWrite enables editing the node config.json.
+	AllowConfigWrite bool
+	// Sessions lists the SSH sessions, it is nil when not served over SSH.
+	Sessions func() []messages.Session
+	// Audit records the actions taken through the UI, it is optional.
+	Audit *messages.AuditLog
+}
+
 // New initializes the TUI.
-func New(requestor *messages.Requestor, addresses []types.Address) Model {
-	styles := style.DefaultStyles()
-	tab := tabs.New([]string{"EXPLORER", "UTILITIES", "ACCOUNTS", "CONFIGURATION", "HELP"})
+func New(requestor *messages.Requestor, addresses []types.Address, options Options) (Model, error) {
+	styles, err := style.ThemeStyles(options.Config.Theme)
+	if err != nil {
+		return Model{}, err
+	}
+	active, err := tabFromName(options.Config.DefaultTab)
+	if err != nil {
+		return Model{}, err
+	}
+	engine, err := alerts.New(options.Config.Alerts)
+	if err != nil {
+		return Model{}, err
+	}
+	tab := tabs.New(tabNames[:])
+	tab.SetActiveIndex(int(active))
 	// The tab content is the only flexible element.
 	// This means the height must grow or shrink to fill the available
 	// window height. It has access to the absolute height but needs to
@@ -66,17 +154,82 @@ func New(requestor *messages.Requestor, addresses []types.Address) Model {
 	footerHeight := 2 // help + status bar
 	tabContentMargin := style.TopHeight + tab.Height() + footerHeight
 	return Model{
-		active:        explorerTab,
-		styles:        styles,
-		Status:        status.New(styles, requestor),
+		active: active,
+		styles: styles,
+		Status: status.New(styles, requestor, status.Options{
+			Health:       options.Health,
+			Consensus:    options.Consensus,
+			NetworkRound: options.NetworkRound,
+			Refresh:      options.Config.Refresh.Status,
+		}),
 		Tabs:          tab,
-		BlockExplorer: explorer.New(styles, requestor, initialWidth, 0, initialHeight, tabContentMargin),
-		Configs:       configs.New(tabContentMargin),
-		Accounts:      accounts.New(styles, requestor, initialHeight, tabContentMargin, addresses),
+		BlockExplorer: explorer.New(styles, requestor, initialWidth, 0, initialHeight, tabContentMargin, options.AddressBook),
+		Configs:       configs.New(styles, requestor, initialHeight, tabContentMargin, options.AllowConfigWrite),
+		Logs:          logs.New(styles, requestor, options.Audit, initialHeight, tabContentMargin),
+		Sessions:      sessions.New(styles, options.Sessions, initialHeight, tabContentMargin),
+		Accounts:      accounts.New(styles, requestor, initialHeight, tabContentMargin, addresses, options.Config, options.AddressBook, engine),
+		Participation: participation.New(styles, requestor, initialHeight, tabContentMargin, options.AddressBook),
 		Help:          help.New(),
-		Footer:        footer.New(styles),
+		Footer:        footer.New(styles, engine),
+		Confirm:       confirm.New(styles),
 		About:         about.New(tabContentMargin, about.GetHelpContent()),
-		Utilities:     about.New(tabContentMargin, about.GetUtilsContent()),
+		Utilities:     about.New(tabContentMargin, about.GetUtilsContent(true)),
 		requestor:     requestor,
+		audit:         options.Audit,
+		user:          messages.LocalUser,
+
+		viewerUtilities: about.New(tabContentMargin, about.GetUtilsContent(false)),
+	}, nil
+}
+
+// operatorGate is implemented by bubbles with actions which operate the node.
+type operatorGate interface {
+	WithOperator(operator bool) tea.Model
+}
+
+// WithOperator returns the Model for a session. Viewers can watch the node
+// but the fast catchup, config edit and participation key actions are hidden
+// and rejected, their watch list changes are not saved, and the session list
+// is not shown.
+func (m Model) WithOperator(operator bool) Model {
+	m.viewer = !operator
+	if m.viewer {
+		m.Utilities = m.viewerUtilities
+	}
+	for _, bubble := range []*tea.Model{&m.Accounts, &m.Participation, &m.Configs, &m.Sessions} {
+		if gate, ok := (*bubble).(operatorGate); ok {
+			*bubble = gate.WithOperator(operator)
+		}
+	}
+	return m
+}
+
+// WithSession returns the Model for an SSH session, actions are audited with
+// the user and key fingerprint.
+func (m Model) WithSession(user, fingerprint string) Model {
+	m.user, m.fingerprint = user, fingerprint
+	return m
+}
+
+// auditCmd records an action taken in the session.
+func (m Model) auditCmd(action string, params map[string]interface{}, response string, err error) tea.Cmd {
+	entry := messages.AuditEntry{
+		User:        m.user,
+		Fingerprint: m.fingerprint,
+		Action:      action,
+		Params:      params,
+		Response:    response,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return m.audit.RecordCmd(entry)
+}
+
+// keys returns the key bindings available to the session.
+func (m Model) keys() constants.KeyMap {
+	if m.viewer {
+		return constants.Keys.ViewerKeys()
 	}
+	return constants.Keys
 }
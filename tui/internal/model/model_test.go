@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorand/node-ui/messages"
+)
+
+func TestAuditCmd(t *testing.T) {
+	audit, err := messages.OpenAuditLog(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := Model{audit: audit, user: messages.LocalUser}
+	session := local.WithSession("alice", "SHA256:abc")
+
+	local.auditCmd("shutdown", nil, "", nil)()
+	session.auditCmd("catchup.start", map[string]interface{}{"network": "testnet"}, "", errors.New("unauthorized"))()
+
+	msg := audit.ReadCmd()().(messages.AuditEntriesMsg)
+	if msg.Err != nil {
+		t.Fatal(msg.Err)
+	}
+	if len(msg.Entries) != 2 {
+		t.Fatalf("read %d entries, want 2", len(msg.Entries))
+	}
+	if got := msg.Entries[0]; got.User != messages.LocalUser || got.Fingerprint != "" || got.Action != "shutdown" {
+		t.Errorf("local entry = %+v, want a local shutdown", got)
+	}
+	if got := msg.Entries[1]; got.User != "alice" || got.Fingerprint != "SHA256:abc" || got.Error != "unauthorized" {
+		t.Errorf("session entry = %+v, want alice's failed catchup", got)
+	}
+
+	// Without an audit log nothing is recorded.
+	if cmd := (Model{}).auditCmd("shutdown", nil, "", nil); cmd != nil {
+		t.Error("auditCmd() without an audit log returned a command")
+	}
+}
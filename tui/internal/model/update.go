@@ -7,6 +7,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/confirm"
+	"github.com/algorand/node-ui/tui/internal/bubbles/explorer"
 	"github.com/algorand/node-ui/tui/internal/constants"
 )
 
@@ -14,6 +16,34 @@ func networkFromID(genesisID string) string {
 	return strings.Split(genesisID, "-")[0]
 }
 
+// inputCapturer is implemented by bubbles which accept text input. While
+// capturing, key presses are not interpreted as global key bindings.
+type inputCapturer interface {
+	CapturesInput() bool
+}
+
+// activeTab returns the bubble for the active tab.
+func (m *Model) activeTab() *tea.Model {
+	switch m.active {
+	case explorerTab:
+		return &m.BlockExplorer
+	case accountTab:
+		return &m.Accounts
+	case participationTab:
+		return &m.Participation
+	case configTab:
+		return &m.Configs
+	case logsTab:
+		return &m.Logs
+	case sessionsTab:
+		return &m.Sessions
+	case helpTab:
+		return &m.About
+	default:
+		return &m.Utilities
+	}
+}
+
 // Update is part of the tea.Model interface.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var (
@@ -25,33 +55,77 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case messages.NetworkMsg:
 		m.network = msg
 
+	case messages.StatusMsg:
+		if msg.Error == nil {
+			m.catchpoint = msg.Status.Catchpoint
+		}
+
+	case confirm.RequestMsg:
+		m.Confirm, cmd = m.Confirm.Open(m.confirmRequest(confirm.Request(msg)))
+		return m, cmd
+
 	case tea.KeyMsg:
+		// The confirmation dialog takes every key press while it is shown.
+		if m.Confirm.Active() {
+			m.Confirm, cmd = m.Confirm.Update(msg)
+			return m, cmd
+		}
+
+		tab := m.activeTab()
+		if c, ok := (*tab).(inputCapturer); ok && c.CapturesInput() {
+			*tab, cmd = (*tab).Update(msg)
+			return m, cmd
+		}
+
 		switch {
 		case key.Matches(msg, constants.Keys.Quit):
+			// Quitting the local UI shuts it down, SSH sessions only disconnect.
+			if m.user == messages.LocalUser {
+				return m, tea.Sequence(m.auditCmd("shutdown", nil, "", nil), tea.Quit)
+			}
 			return m, tea.Quit
-		case key.Matches(msg, constants.Keys.Catchup):
-			return m, messages.StartFastCatchup(networkFromID(m.network.GenesisID))
-		case key.Matches(msg, constants.Keys.AbortCatchup):
-			return m, messages.StopFastCatchup(networkFromID(m.network.GenesisID))
+		case key.Matches(msg, m.keys().Catchup):
+			return m.askCatchup(catchupStart)
+		case key.Matches(msg, m.keys().AbortCatchup):
+			return m.askCatchup(catchupAbort)
 		case key.Matches(msg, constants.Keys.Section):
 			m.active++
-			m.active %= 5
+			m.active %= numTabs
 			m.Tabs.SetActiveIndex(int(m.active))
 			return m, nil
 		}
-		switch m.active {
-		case explorerTab:
-			var explorerCommand tea.Cmd
-			m.BlockExplorer, explorerCommand = m.BlockExplorer.Update(msg)
-			return m, explorerCommand
-		case accountTab:
-		case configTab:
-		case helpTab:
-		case utilitiesTab:
-		}
+		// Keys are only sent to the active tab.
+		*tab, cmd = (*tab).Update(msg)
+		return m, cmd
 
 	case tea.WindowSizeMsg:
 		m.lastResize = msg
+
+	case messages.CatchpointMsg:
+		m, cmd = m.updateCatchup(msg)
+		cmds = append(cmds, cmd)
+
+	case messages.FastCatchupMsg:
+		m, cmd = m.updateCatchup(msg)
+		cmds = append(cmds, cmd)
+		cmds = append(cmds, m.auditCmd("catchup."+msg.Action, map[string]interface{}{
+			"network":    msg.Network,
+			"catchpoint": msg.Catchpoint,
+		}, msg.Response, msg.Err))
+
+	case messages.ParticipationActionMsg:
+		cmds = append(cmds, m.auditCmd("participation."+msg.Kind, msg.Params, msg.Response, msg.Err))
+
+	case messages.NodeConfigSavedMsg:
+		response := ""
+		if msg.Backup != "" {
+			response = "backed up to " + msg.Backup
+		}
+		cmds = append(cmds, m.auditCmd("config.edit", msg.Updates, response, msg.Err))
+
+	case explorer.TransactionShownMsg:
+		m.active = explorerTab
+		m.Tabs.SetActiveIndex(int(m.active))
 	}
 
 	m.Status, cmd = m.Status.Update(msg)
@@ -60,12 +134,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.Accounts, cmd = m.Accounts.Update(msg)
 	cmds = append(cmds, cmd)
 
+	m.Participation, cmd = m.Participation.Update(msg)
+	cmds = append(cmds, cmd)
+
 	m.BlockExplorer, cmd = m.BlockExplorer.Update(msg)
 	cmds = append(cmds, cmd)
 
 	m.Configs, cmd = m.Configs.Update(msg)
 	cmds = append(cmds, cmd)
 
+	m.Logs, cmd = m.Logs.Update(msg)
+	cmds = append(cmds, cmd)
+
+	m.Sessions, cmd = m.Sessions.Update(msg)
+	cmds = append(cmds, cmd)
+
 	m.Footer, cmd = m.Footer.Update(msg)
 	cmds = append(cmds, cmd)
 
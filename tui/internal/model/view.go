@@ -3,8 +3,6 @@ package model
 import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/reflow/indent"
-
-	"github.com/algorand/node-ui/tui/internal/constants"
 )
 
 // TODO: this function could implement a type and be passed to the tab view.
@@ -14,8 +12,14 @@ func (m Model) tabView() string {
 		return m.BlockExplorer.View()
 	case accountTab:
 		return m.Accounts.View()
+	case participationTab:
+		return m.Participation.View()
 	case configTab:
 		return m.Configs.View()
+	case logsTab:
+		return m.Logs.View()
+	case sessionsTab:
+		return m.Sessions.View()
 	case helpTab:
 		return m.About.View()
 	case utilitiesTab:
@@ -46,13 +50,23 @@ func art() string {
 
 // View is part of the tea.Model interface.
 func (m Model) View() string {
+	tab := m.tabView()
+	if m.Confirm.Active() {
+		// The dialog replaces the tab content, keeping the layout height.
+		width := lipgloss.Width(tab)
+		if m.lastResize.Width > width {
+			width = m.lastResize.Width
+		}
+		tab = m.Confirm.View(width, lipgloss.Height(tab))
+	}
+
 	// Compose the different views by joining them together in the right orientation.
 	return lipgloss.JoinVertical(0,
 		lipgloss.JoinHorizontal(0,
 			m.Status.View(),
 			art()),
 		m.Tabs.View(),
-		m.tabView(),
-		m.Help.View(constants.Keys),
+		tab,
+		m.Help.View(m.keys()),
 		m.Footer.View())
 }
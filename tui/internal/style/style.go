@@ -1,6 +1,8 @@
 package style
 
 import (
+	"fmt"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -9,13 +11,15 @@ import (
 
 const (
 	// TopHeight is the hard coded height of the top bubbles.
-	TopHeight = 13
+	TopHeight = 16
 )
 
 // Styles defines styles for the TUI.
 type Styles struct {
 	ActiveBorderColor   lipgloss.Color
 	InactiveBorderColor lipgloss.Color
+	WarningBorderColor  lipgloss.Color
+	CriticalBorderColor lipgloss.Color
 
 	// Accounts area
 	Account           lipgloss.Style
@@ -23,10 +27,13 @@ type Styles struct {
 	AccountGrayText   lipgloss.Style
 	AccountBlueText   lipgloss.Style
 	AccountYellowText lipgloss.Style
+	AccountHelpText   lipgloss.Style
 
 	// Status area
-	Status         lipgloss.Style
-	StatusBoldText lipgloss.Style
+	Status             lipgloss.Style
+	StatusBoldText     lipgloss.Style
+	StatusWarningText  lipgloss.Style
+	StatusCriticalText lipgloss.Style
 
 	// Bottom area
 	Bottom          lipgloss.Style
@@ -53,6 +60,8 @@ func DefaultStyles() *Styles {
 	s.ActiveBorderColor = lipgloss.Color("62")
 	//s.InactiveBorderColor = lipgloss.Color("236")
 	s.InactiveBorderColor = lipgloss.Color("#ABB8C3")
+	s.WarningBorderColor = lipgloss.Color("#FCB900")
+	s.CriticalBorderColor = lipgloss.Color("#EB144C")
 	s.BottomPaginator = lipgloss.NewStyle().
 		Margin(0).
 		Align(lipgloss.Center)
@@ -67,6 +76,7 @@ func DefaultStyles() *Styles {
 	s.AccountGrayText = lipgloss.NewStyle().Foreground(lipgloss.Color("#333333"))
 	s.AccountBlueText = lipgloss.NewStyle().Foreground(lipgloss.Color("#0693E3"))
 	s.AccountYellowText = lipgloss.NewStyle().Foreground(lipgloss.Color("#A3A322"))
+	s.AccountHelpText = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
 
 	// Status
 	s.Status = lipgloss.NewStyle().
@@ -76,6 +86,8 @@ func DefaultStyles() *Styles {
 		Padding(0, 1, 0, 1).
 		MarginLeft(1)
 	s.StatusBoldText = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#0693E3"))
+	s.StatusWarningText = lipgloss.NewStyle().Foreground(s.WarningBorderColor)
+	s.StatusCriticalText = lipgloss.NewStyle().Bold(true).Foreground(s.CriticalBorderColor)
 
 	// Bottom box
 	s.Bottom = lipgloss.NewStyle().
@@ -125,3 +137,34 @@ func DefaultStyles() *Styles {
 
 	return s
 }
+
+// LightStyles returns styles for terminals with a light background.
+func LightStyles() *Styles {
+	s := DefaultStyles()
+
+	s.InactiveBorderColor = lipgloss.Color("#555555")
+	s.Status = s.Status.Copy().BorderForeground(s.InactiveBorderColor)
+	s.Bottom = s.Bottom.Copy().BorderForeground(s.InactiveBorderColor)
+	s.Account = s.Account.Copy().BorderForeground(s.InactiveBorderColor)
+
+	s.AccountBoldText = s.AccountBoldText.Copy().Foreground(lipgloss.Color("#005A9E"))
+	s.AccountBlueText = s.AccountBlueText.Copy().Foreground(lipgloss.Color("#005A9E"))
+	s.AccountYellowText = s.AccountYellowText.Copy().Foreground(lipgloss.Color("#6B6B00"))
+	s.AccountHelpText = s.AccountHelpText.Copy().Foreground(lipgloss.Color("245"))
+	s.StatusBoldText = s.StatusBoldText.Copy().Foreground(lipgloss.Color("#005A9E"))
+	s.BottomListItemKey = s.BottomListItemKey.Copy().Foreground(lipgloss.Color("#6B6B00"))
+
+	return s
+}
+
+// ThemeStyles returns the styles for a named theme, an empty name selects
+// the default theme.
+func ThemeStyles(theme string) (*Styles, error) {
+	switch theme {
+	case "", "default", "dark":
+		return DefaultStyles(), nil
+	case "light":
+		return LightStyles(), nil
+	}
+	return nil, fmt.Errorf("unknown theme '%s', expected 'dark' or 'light'", theme)
+}
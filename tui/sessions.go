@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/algorand/node-ui/messages"
+)
+
+// SessionLimits restricts the SSH sessions, zero values are unlimited.
+type SessionLimits struct {
+	// MaxSessions is the number of concurrent sessions.
+	MaxSessions int
+	// MaxUserSessions is the number of concurrent sessions for each key, or
+	// each user name without public key authentication.
+	MaxUserSessions int
+	// IdleTimeout disconnects sessions without any input for this long.
+	IdleTimeout time.Duration
+}
+
+// trackedSession is an active session, lastInput is in unix nanoseconds.
+type trackedSession struct {
+	info      messages.Session
+	owner     string
+	lastInput atomic.Int64
+}
+
+// sessionTracker enforces the session limits and lists the active sessions.
+type sessionTracker struct {
+	limits SessionLimits
+	// keys resolve the session roles, nil when every session is an operator.
+	keys []authorizedKey
+
+	mu       sync.Mutex
+	nextID   uint64
+	sessions map[uint64]*trackedSession
+}
+
+func newSessionTracker(limits SessionLimits, keys []authorizedKey) *sessionTracker {
+	return &sessionTracker{
+		limits:   limits,
+		keys:     keys,
+		sessions: make(map[uint64]*trackedSession),
+	}
+}
+
+// inputSession records the time of the last input read from the session.
+type inputSession struct {
+	ssh.Session
+	tracked *trackedSession
+}
+
+func (s inputSession) Read(p []byte) (int, error) {
+	n, err := s.Session.Read(p)
+	if n > 0 {
+		s.tracked.lastInput.Store(time.Now().UnixNano())
+	}
+	return n, err
+}
+
+// newSession describes a connecting session.
+func (t *sessionTracker) newSession(s ssh.Session) *trackedSession {
+	now := time.Now()
+	tracked := &trackedSession{
+		info: messages.Session{
+			User:       s.User(),
+			Role:       string(RoleOperator),
+			RemoteAddr: s.RemoteAddr().String(),
+			Since:      now,
+		},
+		owner: "user " + s.User(),
+	}
+	if key := s.PublicKey(); key != nil {
+		tracked.info.Fingerprint = gossh.FingerprintSHA256(key)
+		tracked.owner = tracked.info.Fingerprint
+	}
+	if t.keys != nil {
+		authorized, _ := findKey(t.keys, s.PublicKey())
+		tracked.info.Role = string(authorized.role)
+	}
+	tracked.lastInput.Store(now.UnixNano())
+	return tracked
+}
+
+// add registers the session unless a limit is reached.
+func (t *sessionTracker) add(tracked *trackedSession) (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if limit := t.limits.MaxSessions; limit > 0 && len(t.sessions) >= limit {
+		return 0, fmt.Errorf("the server is full, %d sessions are connected", len(t.sessions))
+	}
+	if limit := t.limits.MaxUserSessions; limit > 0 {
+		count := 0
+		for _, other := range t.sessions {
+			if other.owner == tracked.owner {
+				count++
+			}
+		}
+		if count >= limit {
+			return 0, fmt.Errorf("%s already has %d sessions connected", tracked.owner, count)
+		}
+	}
+	t.nextID++
+	t.sessions[t.nextID] = tracked
+	return t.nextID, nil
+}
+
+func (t *sessionTracker) remove(id uint64) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.sessions, id)
+	return len(t.sessions)
+}
+
+// Sessions lists the active sessions, oldest first.
+func (t *sessionTracker) Sessions() []messages.Session {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sessions := make([]messages.Session, 0, len(t.sessions))
+	for _, tracked := range t.sessions {
+		info := tracked.info
+		info.LastInput = time.Unix(0, tracked.lastInput.Load())
+		sessions = append(sessions, info)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Since.Before(sessions[j].Since) })
+	return sessions
+}
+
+// closeWhenIdle closes the connection once there was no input for the idle
+// timeout, which ends the session. It returns when the session ends.
+func (t *sessionTracker) closeWhenIdle(s ssh.Session, tracked *trackedSession, idle *atomic.Bool) {
+	for {
+		wait := t.limits.IdleTimeout - time.Since(time.Unix(0, tracked.lastInput.Load()))
+		if wait <= 0 {
+			idle.Store(true)
+			if conn, ok := s.Context().Value(ssh.ContextKeyConn).(interface{ Close() error }); ok {
+				conn.Close()
+			}
+			return
+		}
+		select {
+		case <-s.Context().Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// middleware rejects sessions beyond the limits, logs connects and
+// disconnects, and disconnects idle sessions.
+func (t *sessionTracker) middleware() wish.Middleware {
+	return func(next ssh.Handler) ssh.Handler {
+		return func(s ssh.Session) {
+			tracked := t.newSession(s)
+			info := tracked.info
+			id, err := t.add(tracked)
+			if err != nil {
+				log.Printf("%s rejected %s %s: %v", info.User, info.RemoteAddr, info.Fingerprint, err)
+				wish.Fatalln(s, err)
+				return
+			}
+			log.Printf("%s connect %s %s role %s", info.User, info.RemoteAddr, info.Fingerprint, info.Role)
+
+			var idle atomic.Bool
+			if t.limits.IdleTimeout > 0 {
+				go t.closeWhenIdle(s, tracked, &idle)
+			}
+			next(inputSession{Session: s, tracked: tracked})
+
+			reason := ""
+			if idle.Load() {
+				reason = ", idle"
+			}
+			remaining := t.remove(id)
+			log.Printf("%s disconnect %s after %s%s, %d sessions remaining",
+				info.User, info.RemoteAddr, time.Since(info.Since).Round(time.Second), reason, remaining)
+		}
+	}
+}
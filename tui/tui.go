@@ -4,9 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
-	"path"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -14,25 +15,118 @@ import (
 	"github.com/charmbracelet/ssh"
 	"github.com/charmbracelet/wish"
 	bm "github.com/charmbracelet/wish/bubbletea"
-	lm "github.com/charmbracelet/wish/logging"
+	gossh "golang.org/x/crypto/ssh"
 
 	"github.com/algorand/go-algorand-sdk/v2/types"
 
+	"github.com/algorand/node-ui/config"
 	"github.com/algorand/node-ui/messages"
+	"github.com/algorand/node-ui/tui/internal/bubbles/status"
 	"github.com/algorand/node-ui/tui/internal/model"
 )
 
-const host = "0.0.0.0"
+// defaultBind is the SSH server address when none is configured.
+const defaultBind = "0.0.0.0"
 
-func getTeaHandler(model model.Model) bm.Handler {
-	return func(_ ssh.Session) (tea.Model, []tea.ProgramOption) {
-		return model, []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseCellMotion()}
+// getTeaHandler starts a session with the role of the authorized key, its
+// actions are audited with the SSH user and key. Without authorized keys every
+// session is an operator.
+func getTeaHandler(model model.Model, keys []authorizedKey) bm.Handler {
+	return func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+		opts := []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseCellMotion()}
+		fingerprint := ""
+		if key := s.PublicKey(); key != nil {
+			fingerprint = gossh.FingerprintSHA256(key)
+		}
+		session := model.WithSession(s.User(), fingerprint)
+		if keys == nil {
+			return session, opts
+		}
+		// Public key auth already rejected unknown keys.
+		key, _ := findKey(keys, s.PublicKey())
+		return session.WithOperator(key.role == RoleOperator), opts
 	}
 }
 
+// Options are optional settings for the UI.
+type Options struct {
+	// StallThreshold is how long without a new round before the node is flagged as stalled.
+	StallThreshold time.Duration
+	// CatchupThreshold is how long the node may be catching up before it is flagged.
+	CatchupThreshold time.Duration
+	// BehindThreshold and BehindCriticalThreshold are how many rounds the
+	// node may be behind the network round before it is flagged.
+	BehindThreshold         uint64
+	BehindCriticalThreshold uint64
+	// Consensus overrides the built-in consensus parameters.
+	Consensus messages.ConsensusTable
+	// NetworkRound is the sync target when there is no reference node.
+	NetworkRound uint64
+	// Config holds the persisted settings, watch list changes are saved to it.
+	Config *config.Config
+	// AddressBook labels accounts wherever addresses are shown.
+	AddressBook *config.AddressBook
+	// AllowConfigWrite enables editing the node config.json from the UI.
+	AllowConfigWrite bool
+	// SSHAuthorizedKeys is an authorized_keys file, when set only those keys
+	// may connect to the SSH server and each key has a Role.
+	SSHAuthorizedKeys string
+	// SSHBind is the address the SSH server listens on, all interfaces by
+	// default.
+	SSHBind string
+	// SSHHostKey is the SSH host key path, it is generated when missing. The
+	// default is ~/.ssh/term_info_ed25519.
+	SSHHostKey string
+	// Sessions limits the SSH sessions.
+	Sessions SessionLimits
+	// Audit records the actions taken through the UI, it is optional.
+	Audit *messages.AuditLog
+}
+
 // Start ...
-func Start(port uint64, requestor *messages.Requestor, addresses []types.Address) {
-	model := model.New(requestor, addresses)
+func Start(port uint64, requestor *messages.Requestor, addresses []types.Address, options Options) {
+	cfg := options.Config
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	// The authorized keys are loaded first, the session list needs them.
+	var (
+		keys     []authorizedKey
+		tracker  *sessionTracker
+		sessions func() []messages.Session
+	)
+	if port != 0 {
+		if options.SSHAuthorizedKeys != "" {
+			var err error
+			keys, err = loadAuthorizedKeys(options.SSHAuthorizedKeys)
+			if err != nil {
+				log.Fatalf("Unable to load SSH authorized keys: %v", err)
+			}
+		}
+		tracker = newSessionTracker(options.Sessions, keys)
+		sessions = tracker.Sessions
+	}
+
+	model, err := model.New(requestor, addresses, model.Options{
+		Health: status.HealthThresholds{
+			Stall:          options.StallThreshold,
+			Catchup:        options.CatchupThreshold,
+			Behind:         options.BehindThreshold,
+			BehindCritical: options.BehindCriticalThreshold,
+		},
+		Consensus:        options.Consensus,
+		NetworkRound:     options.NetworkRound,
+		Config:           cfg,
+		AddressBook:      options.AddressBook,
+		AllowConfigWrite: options.AllowConfigWrite,
+		Sessions:         sessions,
+		Audit:            options.Audit,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration: %v\n", err)
+		os.Exit(1)
+	}
 
 	// Run directly
 	if port == 0 {
@@ -47,34 +141,62 @@ func Start(port uint64, requestor *messages.Requestor, addresses []types.Address
 	}
 
 	// Run on ssh server.
-	dirname, err := os.UserHomeDir()
+	bind := options.SSHBind
+	if bind == "" {
+		bind = defaultBind
+	}
+	address := net.JoinHostPort(bind, strconv.FormatUint(port, 10))
+	keyPath, err := hostKeyPath(options.SSHHostKey)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	hostKey, err := loadHostKey(keyPath)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalln(err)
 	}
 
-	sshServer, err := wish.NewServer(
-		wish.WithAddress(fmt.Sprintf("%s:%d", host, port)),
-		wish.WithHostKeyPath(path.Join(dirname, ".ssh/term_info_ed25519")),
-		wish.WithMiddleware(
-			bm.Middleware(getTeaHandler(model)),
-			lm.Middleware(),
-		),
-	)
+	serverOptions := []ssh.Option{
+		wish.WithAddress(address),
+		wish.WithHostKeyPEM(hostKey),
+	}
+	if keys != nil {
+		serverOptions = append(serverOptions, wish.WithPublicKeyAuth(func(_ ssh.Context, key ssh.PublicKey) bool {
+			_, ok := findKey(keys, key)
+			return ok
+		}))
+		log.Printf("Loaded %d SSH authorized keys from %s", len(keys), options.SSHAuthorizedKeys)
+	} else {
+		log.Printf("WARNING: no SSH authorized keys, anyone who can reach port %d can operate the node", port)
+	}
+	serverOptions = append(serverOptions, wish.WithMiddleware(
+		bm.Middleware(getTeaHandler(model, keys)),
+		tracker.middleware(),
+	))
+
+	sshServer, err := wish.NewServer(serverOptions...)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
-	log.Printf("Starting SSH server on %s:%d", host, port)
+	log.Printf("Starting SSH server on %s", address)
 	go func() {
 		if err = sshServer.ListenAndServe(); err != nil {
 			log.Fatalln(err)
 		}
 	}()
 
-	<-done
+	sig := <-done
 	log.Println("Stopping SSH server")
+	err = options.Audit.Record(messages.AuditEntry{
+		User:   messages.LocalUser,
+		Action: "shutdown",
+		Params: map[string]interface{}{"signal": sig.String()},
+	})
+	if err != nil {
+		log.Printf("Unable to write the audit log: %v", err)
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer func() { cancel() }()
 	if err := sshServer.Shutdown(ctx); err != nil {